@@ -0,0 +1,22 @@
+package address
+
+import (
+	"errors"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+var (
+	ErrInvalidFormatsData = errors.New("invalid address formats data")
+)
+
+var (
+	ErrMissingComponent = govalidatorfield.NewError(
+		"address.missing_component",
+		"address component is required",
+	)
+	ErrInvalidPostalCode = govalidatorfield.NewError(
+		"address.invalid_postal_code",
+		"postal code format is not valid for the country",
+	)
+)
@@ -0,0 +1,120 @@
+package address
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+type (
+	// Format is the address format of a country
+	Format struct {
+		// PostalCode is the pattern of the postal codes, nil if the country does not use postal codes
+		PostalCode *regexp.Regexp
+
+		// Required are the required address components
+		Required []Component
+	}
+
+	// Formats is the table of the address formats by ISO 3166-1 alpha-2 country code
+	Formats struct {
+		formats map[string]*Format
+	}
+
+	// formatData is the JSON representation of the address format of a country
+	formatData struct {
+		PostalCode string      `json:"postal_code,omitempty"`
+		Required   []Component `json:"required"`
+	}
+)
+
+var (
+	//go:embed data/formats.json
+	formatsData []byte
+
+	// defaultFormats is the parsed bundled address formats table
+	defaultFormats     *Formats
+	defaultFormatsOnce sync.Once
+
+	// defaultFormat is the format of the countries without address format metadata
+	defaultFormat = &Format{
+		Required: []Component{ComponentStreet, ComponentCity},
+	}
+)
+
+// LoadFormats loads an address formats table from JSON, e.g.
+// '{"VE": {"postal_code": "\\d{4}", "required": ["street", "city", "region"]}}'
+//
+// Parameters:
+//
+//   - reader: the reader of the address formats table
+//
+// Returns:
+//
+//   - *Formats: the address formats table
+//   - error: if the table could not be read, decoded or compiled
+func LoadFormats(reader io.Reader) (*Formats, error) {
+	var data map[string]formatData
+	if err := json.NewDecoder(reader).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	f := &Formats{
+		formats: make(map[string]*Format, len(data)),
+	}
+	for country, countryData := range data {
+		format := &Format{
+			Required: countryData.Required,
+		}
+
+		// Compile the postal code pattern, anchored to the whole postal code
+		if countryData.PostalCode != "" {
+			pattern, err := regexp.Compile("^(?:" + countryData.PostalCode + ")$")
+			if err != nil {
+				return nil, ErrInvalidFormatsData
+			}
+			format.PostalCode = pattern
+		}
+		f.formats[strings.ToUpper(strings.TrimSpace(country))] = format
+	}
+	return f, nil
+}
+
+// DefaultFormats returns the bundled address formats table, which follows the postal code patterns and required
+// fields of the Google address metadata for the main countries of the Americas, Europe and Asia-Pacific
+//
+// Returns:
+//
+//   - *Formats: the address formats table
+func DefaultFormats() *Formats {
+	defaultFormatsOnce.Do(
+		func() {
+			// The bundled table is embedded and well-formed, so it cannot fail to be loaded
+			defaultFormats, _ = LoadFormats(bytes.NewReader(formatsData))
+		},
+	)
+	return defaultFormats
+}
+
+// Lookup returns the address format of a country
+//
+// Parameters:
+//
+//   - country: the ISO 3166-1 alpha-2 country code, case-insensitive
+//
+// Returns:
+//
+//   - *Format: the address format, the default format that requires the street and the city if the country has none
+//   - bool: true if the country has an address format, false otherwise
+func (f *Formats) Lookup(country string) (*Format, bool) {
+	if f != nil {
+		if format, ok := f.formats[strings.ToUpper(strings.TrimSpace(country))]; ok {
+			return format, true
+		}
+	}
+	return defaultFormat, false
+}
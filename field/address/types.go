@@ -0,0 +1,100 @@
+package address
+
+type (
+	// Component is a component of a postal address
+	Component string
+
+	// Address is a postal address
+	Address struct {
+		// Country is the ISO 3166-1 alpha-2 country code
+		Country string
+
+		// Street is the street address lines
+		Street string
+
+		// City is the city or locality
+		City string
+
+		// Region is the state, province or other top-level administrative area
+		Region string
+
+		// PostalCode is the postal or ZIP code
+		PostalCode string
+	}
+
+	// FieldNames are the names of the fields of an address struct that hold each component, the empty names are
+	// replaced by the default ones, which are the names of the Address fields
+	FieldNames struct {
+		Country    string
+		Street     string
+		City       string
+		Region     string
+		PostalCode string
+	}
+
+	// Options is the address validation options struct
+	Options struct {
+		// Formats is the address formats table, if nil the bundled table is used
+		Formats *Formats
+
+		// FieldNames are the names of the fields of the address struct validated by the struct rule
+		FieldNames FieldNames
+
+		// DefaultCountry is the country used when the address has no country (optional, can be empty)
+		DefaultCountry string
+	}
+)
+
+const (
+	ComponentCountry    Component = "country"
+	ComponentStreet     Component = "street"
+	ComponentCity       Component = "city"
+	ComponentRegion     Component = "region"
+	ComponentPostalCode Component = "postal_code"
+)
+
+const (
+	// ComponentMetadataKey is the metadata key of the address component in the validation errors
+	ComponentMetadataKey = "component"
+
+	// CountryMetadataKey is the metadata key of the country in the validation errors
+	CountryMetadataKey = "country"
+)
+
+// Get returns the value of a component of the address
+//
+// Parameters:
+//
+//   - component: the address component
+//
+// Returns:
+//
+//   - string: the value of the component
+func (a Address) Get(component Component) string {
+	switch component {
+	case ComponentCountry:
+		return a.Country
+	case ComponentStreet:
+		return a.Street
+	case ComponentCity:
+		return a.City
+	case ComponentRegion:
+		return a.Region
+	case ComponentPostalCode:
+		return a.PostalCode
+	}
+	return ""
+}
+
+// get returns the field name of a component
+//
+// Parameters:
+//
+//   - component: the address component
+//
+// Returns:
+//
+//   - string: the field name
+func (f FieldNames) get(component Component) string {
+	return Address(f).Get(component)
+}
@@ -0,0 +1,135 @@
+package address
+
+import (
+	"strings"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+// ValidatePostalCode validates a postal code against the pattern of its country
+//
+// Parameters:
+//
+//   - country: the ISO 3166-1 alpha-2 country code
+//   - postalCode: the postal code to validate, case-insensitive
+//   - formats: the address formats table (optional, if nil the bundled table is used)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the postal code is valid or the country has no postal code pattern
+func ValidatePostalCode(country, postalCode string, formats *Formats) []error {
+	if formats == nil {
+		formats = DefaultFormats()
+	}
+
+	format, _ := formats.Lookup(country)
+	if format.PostalCode == nil {
+		return nil
+	}
+	if !format.PostalCode.MatchString(strings.ToUpper(strings.TrimSpace(postalCode))) {
+		return []error{
+			ErrInvalidPostalCode.WithMetadata(CountryMetadataKey, strings.ToUpper(strings.TrimSpace(country))),
+		}
+	}
+	return nil
+}
+
+// Validate validates an address against the address format of its country
+//
+// Parameters:
+//
+//   - address: the address to validate
+//   - options: the address validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - map[Component][]error: the validation errors by address component, nil if the address is valid
+func Validate(address Address, options *Options) map[Component][]error {
+	if options == nil {
+		options = &Options{}
+	}
+	formats := options.Formats
+	if formats == nil {
+		formats = DefaultFormats()
+	}
+
+	// Get the country of the address
+	country := strings.TrimSpace(address.Country)
+	if country == "" {
+		country = options.DefaultCountry
+	}
+	if country == "" {
+		return map[Component][]error{
+			ComponentCountry: {ErrMissingComponent.WithMetadata(ComponentMetadataKey, string(ComponentCountry))},
+		}
+	}
+
+	errs := make(map[Component][]error)
+
+	// Check the required components
+	format, _ := formats.Lookup(country)
+	for _, component := range format.Required {
+		if strings.TrimSpace(address.Get(component)) == "" {
+			errs[component] = append(
+				errs[component],
+				ErrMissingComponent.WithMetadata(ComponentMetadataKey, string(component)),
+			)
+		}
+	}
+
+	// Check the postal code, if any
+	if postalCode := strings.TrimSpace(address.PostalCode); postalCode != "" {
+		if postalCodeErrs := ValidatePostalCode(country, postalCode, formats); len(postalCodeErrs) > 0 {
+			errs[ComponentPostalCode] = append(errs[ComponentPostalCode], postalCodeErrs...)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// NewStructRule creates a struct-level rule that validates an address struct against the address format of its
+// country, which can be added to the nested mapper of the address struct, e.g.
+// mapper.GetFieldNestedMapper("Address").AddStructRule(address.NewStructRule(nil))
+//
+// Parameters:
+//
+//   - options: the address validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - func(structInstance any) map[string][]error: the struct-level rule, whose errors are keyed by the field names
+func NewStructRule(options *Options) func(structInstance any) map[string][]error {
+	if options == nil {
+		options = &Options{}
+	}
+	fieldNames := govalidatorfield.WithDefaultFieldNames(options.FieldNames)
+
+	return func(structInstance any) map[string][]error {
+		// Get the address components from the struct fields
+		stringField := func(fieldName string) string {
+			value, _ := govalidatorfield.StringField(structInstance, fieldName)
+			return value
+		}
+		address := Address{
+			Country:    stringField(fieldNames.Country),
+			Street:     stringField(fieldNames.Street),
+			City:       stringField(fieldNames.City),
+			Region:     stringField(fieldNames.Region),
+			PostalCode: stringField(fieldNames.PostalCode),
+		}
+
+		// Key the validation errors by the field names
+		componentsErrs := Validate(address, options)
+		if componentsErrs == nil {
+			return nil
+		}
+		fieldsErrs := make(map[string][]error, len(componentsErrs))
+		for component, errs := range componentsErrs {
+			fieldsErrs[fieldNames.get(component)] = errs
+		}
+		return fieldsErrs
+	}
+}
@@ -0,0 +1,131 @@
+package address
+
+import (
+	"errors"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestValidatePostalCode(t *testing.T) {
+	for _, tt := range []struct {
+		country    string
+		postalCode string
+		valid      bool
+	}{
+		{country: "US", postalCode: "94105", valid: true},
+		{country: "us", postalCode: "94105-1234", valid: true},
+		{country: "US", postalCode: "9410"},
+		{country: "CA", postalCode: "k1a 0b1", valid: true},
+		{country: "CA", postalCode: "D1A 0B1"},
+		{country: "GB", postalCode: "SW1A 1AA", valid: true},
+		{country: "GB", postalCode: "GIR 0AA", valid: true},
+		{country: "GB", postalCode: "SW1A 1CA"},
+		{country: "NL", postalCode: "1012 AB", valid: true},
+		{country: "NL", postalCode: "1012"},
+		{country: "JP", postalCode: "100-0001", valid: true},
+		{country: "BR", postalCode: "01310-100", valid: true},
+		{country: "PL", postalCode: "00950"},
+		{country: "ZZ", postalCode: "anything", valid: true},
+	} {
+		errs := ValidatePostalCode(tt.country, tt.postalCode, nil)
+		if (len(errs) == 0) != tt.valid {
+			t.Errorf("ValidatePostalCode(%q, %q) = %v, want valid: %v", tt.country, tt.postalCode, errs, tt.valid)
+		}
+		for _, err := range errs {
+			if !errors.Is(err, ErrInvalidPostalCode) {
+				t.Errorf(
+					"ValidatePostalCode(%q, %q) error = %v, want %v",
+					tt.country,
+					tt.postalCode,
+					err,
+					ErrInvalidPostalCode,
+				)
+			}
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		address Address
+		options *Options
+		want    []Component
+	}{
+		{
+			name:    "valid",
+			address: Address{Country: "DE", Street: "Unter den Linden 1", City: "Berlin", PostalCode: "10117"},
+		},
+		{
+			name:    "missing country",
+			address: Address{Street: "Unter den Linden 1", City: "Berlin"},
+			want:    []Component{ComponentCountry},
+		},
+		{
+			name:    "default country",
+			address: Address{Street: "Unter den Linden 1", City: "Berlin", PostalCode: "10117"},
+			options: &Options{DefaultCountry: "DE"},
+		},
+		{
+			name:    "missing required components",
+			address: Address{Country: "US", Street: "1 Market St", PostalCode: "94105"},
+			want:    []Component{ComponentCity, ComponentRegion},
+		},
+		{
+			name:    "invalid postal code",
+			address: Address{Country: "DE", Street: "Unter den Linden 1", City: "Berlin", PostalCode: "1011"},
+			want:    []Component{ComponentPostalCode},
+		},
+		{
+			name:    "unknown country requires the street and the city",
+			address: Address{Country: "ZZ", Street: "Main St"},
+			want:    []Component{ComponentCity},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := Validate(tt.address, tt.options)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("Validate = %v, want errors for %v", errs, tt.want)
+				}
+				for _, component := range tt.want {
+					if len(errs[component]) == 0 {
+						t.Errorf("Validate = %v, want errors for %v", errs, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestNewStructRule(t *testing.T) {
+	type shippingAddress struct {
+		CountryCode string
+		Line1       *string
+		City        string
+		State       string
+		ZIP         string
+	}
+	line1 := "1 Market St"
+	rule := NewStructRule(
+		&Options{
+			FieldNames: FieldNames{Country: "CountryCode", Street: "Line1", Region: "State", PostalCode: "ZIP"},
+		},
+	)
+
+	// The errors are keyed by the field names of the struct
+	errs := rule(&shippingAddress{CountryCode: "US", Line1: &line1, City: "San Francisco", ZIP: "9410"})
+	if len(errs) != 2 || len(errs["State"]) != 1 || len(errs["ZIP"]) != 1 {
+		t.Fatalf("rule = %v, want errors for State and ZIP", errs)
+	}
+	if got := govalidatorfield.GetMetadata(errs["State"][0])[ComponentMetadataKey]; got != string(ComponentRegion) {
+		t.Errorf("State error component = %q, want %q", got, ComponentRegion)
+	}
+
+	// A valid struct has no errors
+	valid := shippingAddress{CountryCode: "US", Line1: &line1, City: "San Francisco", State: "CA", ZIP: "94105"}
+	if errs = rule(valid); errs != nil {
+		t.Errorf("rule = %v, want nil", errs)
+	}
+}
@@ -0,0 +1,121 @@
+package birthdate
+
+import (
+	"fmt"
+	"time"
+)
+
+// isLeapYear checks if the year is a leap year
+//
+// Parameters:
+//
+//   - year: the year to check
+//
+// Returns:
+//
+//   - bool: true if the year is a leap year, false otherwise
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// Age returns the age in complete years on the calendar. The birthdate is read as a civil date in its own timezone,
+// while the current date is taken in the reference timezone. People born on February 29 have their birthday on March 1
+// in non-leap years
+//
+// Parameters:
+//
+//   - birth: the birthdate
+//   - now: the current time
+//   - loc: the reference timezone (optional, if nil the timezone of now is used)
+//
+// Returns:
+//
+//   - int: the age in years, negative if the birthdate is after the current date
+func Age(birth, now time.Time, loc *time.Location) int {
+	if loc != nil {
+		now = now.In(loc)
+	}
+	birthYear, birthMonth, birthDay := birth.Date()
+	year, month, day := now.Date()
+
+	// Move the February 29 birthdays to March 1 in non-leap years
+	if birthMonth == time.February && birthDay == 29 && !isLeapYear(year) {
+		birthMonth, birthDay = time.March, 1
+	}
+
+	// Subtract a year if the birthday has not been reached yet
+	age := year - birthYear
+	if month < birthMonth || (month == birthMonth && day < birthDay) {
+		age--
+	}
+	return age
+}
+
+// IsAfterToday checks if the birthdate civil date is after the current date in the reference timezone
+//
+// Parameters:
+//
+//   - birth: the birthdate
+//   - now: the current time
+//   - loc: the reference timezone (optional, if nil the timezone of now is used)
+//
+// Returns:
+//
+//   - bool: true if the birthdate is in the future, false otherwise
+func IsAfterToday(birth, now time.Time, loc *time.Location) bool {
+	if loc != nil {
+		now = now.In(loc)
+	}
+	birthYear, birthMonth, birthDay := birth.Date()
+	year, month, day := now.Date()
+
+	if birthYear != year {
+		return birthYear > year
+	}
+	if birthMonth != month {
+		return birthMonth > month
+	}
+	return birthDay > day
+}
+
+// Validate validates a birthdate against the birthdate options
+//
+// Parameters:
+//
+//   - birth: the birthdate to validate
+//   - now: the current time
+//   - options: the birthdate options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the birthdate is valid
+func Validate(birth, now time.Time, options *Options) []error {
+	var loc *time.Location
+	if options != nil {
+		loc = options.Location
+	}
+
+	var errs []error
+
+	// Check if the birthdate is after the current date
+	if IsAfterToday(birth, now, loc) {
+		errs = append(errs, ErrInvalidBirthdate)
+	}
+
+	// Check if the birthdate options are nil
+	if options == nil {
+		return errs
+	}
+
+	// Check if the age is less than the minimum age
+	age := Age(birth, now, loc)
+	if options.MinimumAge > 0 && age < options.MinimumAge {
+		errs = append(errs, fmt.Errorf(ErrMinimumAge, options.MinimumAge))
+	}
+
+	// Check if the age is greater than the maximum age
+	if options.MaximumAge > 0 && age > options.MaximumAge {
+		errs = append(errs, fmt.Errorf(ErrMaximumAge, options.MaximumAge))
+	}
+	return errs
+}
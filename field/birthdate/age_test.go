@@ -0,0 +1,129 @@
+package birthdate
+
+import (
+	"testing"
+	"time"
+)
+
+// date returns the midnight of the given date in the given timezone
+func date(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+func TestAge(t *testing.T) {
+	tokyo := time.FixedZone("UTC+9", 9*60*60)
+	honolulu := time.FixedZone("UTC-10", -10*60*60)
+	birth := date(2000, time.June, 15, time.UTC)
+	leapBirth := date(2004, time.February, 29, time.UTC)
+
+	for _, tt := range []struct {
+		name  string
+		birth time.Time
+		now   time.Time
+		loc   *time.Location
+		want  int
+	}{
+		{name: "day before birthday", birth: birth, now: date(2018, time.June, 14, time.UTC), want: 17},
+		{name: "day of birthday", birth: birth, now: date(2018, time.June, 15, time.UTC), want: 18},
+		{name: "day after birthday", birth: birth, now: date(2018, time.June, 16, time.UTC), want: 18},
+		{name: "month before birthday", birth: birth, now: date(2018, time.May, 31, time.UTC), want: 17},
+		{name: "birthdate after now", birth: birth, now: date(1999, time.June, 15, time.UTC), want: -1},
+		{
+			name:  "february 29 birth on february 28 of non-leap year",
+			birth: leapBirth,
+			now:   date(2022, time.February, 28, time.UTC),
+			want:  17,
+		},
+		{
+			name:  "february 29 birth on march 1 of non-leap year",
+			birth: leapBirth,
+			now:   date(2022, time.March, 1, time.UTC),
+			want:  18,
+		},
+		{
+			name:  "february 29 birth on february 28 of leap year",
+			birth: leapBirth,
+			now:   date(2024, time.February, 28, time.UTC),
+			want:  19,
+		},
+		{
+			name:  "february 29 birth on february 29 of leap year",
+			birth: leapBirth,
+			now:   date(2024, time.February, 29, time.UTC),
+			want:  20,
+		},
+		{
+			name:  "february 29 birth on february 28 of century non-leap year",
+			birth: date(2096, time.February, 29, time.UTC),
+			now:   date(2100, time.February, 28, time.UTC),
+			want:  3,
+		},
+		{
+			name:  "utc midnight of birthday is the day before in honolulu",
+			birth: birth,
+			now:   date(2018, time.June, 15, time.UTC),
+			loc:   honolulu,
+			want:  17,
+		},
+		{
+			name:  "utc evening before birthday is the birthday in tokyo",
+			birth: birth,
+			now:   time.Date(2018, time.June, 14, 15, 0, 0, 0, time.UTC),
+			loc:   tokyo,
+			want:  18,
+		},
+		{
+			name:  "utc evening before birthday without timezone",
+			birth: birth,
+			now:   time.Date(2018, time.June, 14, 15, 0, 0, 0, time.UTC),
+			want:  17,
+		},
+		{
+			name:  "birthdate civil date is read in its own timezone",
+			birth: date(2000, time.June, 15, tokyo),
+			now:   date(2018, time.June, 15, time.UTC),
+			want:  18,
+		},
+		{
+			name:  "midnight of birthday in reference timezone",
+			birth: birth,
+			now:   date(2018, time.June, 15, tokyo),
+			loc:   tokyo,
+			want:  18,
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := Age(tt.birth, tt.now, tt.loc); got != tt.want {
+					t.Errorf("Age(%v, %v, %v) = %d, want %d", tt.birth, tt.now, tt.loc, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestValidateWithFixedClock(t *testing.T) {
+	clock := NewFixedClock(time.Date(2018, time.June, 15, 12, 0, 0, 0, time.UTC))
+	options := &Options{MinimumAge: 18, MaximumAge: 100}
+
+	for _, tt := range []struct {
+		name    string
+		birth   time.Time
+		wantErr bool
+	}{
+		{name: "turns minimum age today", birth: date(2000, time.June, 15, time.UTC)},
+		{name: "turns minimum age tomorrow", birth: date(2000, time.June, 16, time.UTC), wantErr: true},
+		{name: "maximum age", birth: date(1918, time.June, 15, time.UTC)},
+		{name: "over maximum age", birth: date(1917, time.June, 15, time.UTC), wantErr: true},
+		{name: "future birthdate", birth: date(2018, time.June, 16, time.UTC), wantErr: true},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := Validate(tt.birth, clock.Now(), options)
+				if gotErr := len(errs) != 0; gotErr != tt.wantErr {
+					t.Errorf("Validate(%v) = %v, want errors: %v", tt.birth, errs, tt.wantErr)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,129 @@
+package birthdate
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// CivilDateLayout is the layout of the civil dates, as defined by RFC 3339 full-date
+	CivilDateLayout = "2006-01-02"
+)
+
+// ParseCivilDate strictly parses a civil date in the YYYY-MM-DD format, rejecting dates that do not exist such as
+// 2023-02-30 or 0000-01-01, since there is no year zero in the calendar
+//
+// Parameters:
+//
+//   - value: the civil date to parse
+//
+// Returns:
+//
+//   - time.Time: the civil date at midnight UTC
+//   - error: the validation error, if the value is not a valid civil date
+func ParseCivilDate(value string) (time.Time, error) {
+	// Check the format of the civil date
+	if len(value) != len(CivilDateLayout) {
+		return time.Time{}, ErrInvalidDateFormat
+	}
+	for i, r := range value {
+		if i == 4 || i == 7 {
+			if r != '-' {
+				return time.Time{}, ErrInvalidDateFormat
+			}
+		} else if r < '0' || r > '9' {
+			return time.Time{}, ErrInvalidDateFormat
+		}
+	}
+
+	// Parse the civil date, which fails for out of range months and days
+	date, err := time.Parse(CivilDateLayout, value)
+	if err != nil || date.Year() == 0 {
+		return time.Time{}, ErrInvalidDate
+	}
+	return date, nil
+}
+
+// FromDate converts a civil date message, such as google.type.Date, to a time
+//
+// Parameters:
+//
+//   - date: the civil date message
+//
+// Returns:
+//
+//   - time.Time: the civil date at midnight UTC
+//   - error: the validation error, if the date is incomplete or does not exist
+func FromDate(date Date) (time.Time, error) {
+	if date == nil {
+		return time.Time{}, ErrIncompleteDate
+	}
+
+	// Check if the date is complete, google.type.Date allows zero values for partial dates
+	year, month, day := int(date.GetYear()), int(date.GetMonth()), int(date.GetDay())
+	if year == 0 || month == 0 || day == 0 {
+		return time.Time{}, ErrIncompleteDate
+	}
+
+	// Check if the date exists, time.Date normalizes out of range values
+	civilDate := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if civilDate.Year() != year || int(civilDate.Month()) != month || civilDate.Day() != day {
+		return time.Time{}, ErrInvalidDate
+	}
+	return civilDate, nil
+}
+
+// FromTimestamp converts a google.protobuf.Timestamp to a civil date, which is taken in the given timezone, since
+// the same instant can be a different day in UTC, e.g. the midnight of a birthday in UTC+2 is the day before in UTC
+//
+// Parameters:
+//
+//   - timestamp: the timestamp
+//   - loc: the timezone of the civil date (optional, if nil UTC is used)
+//
+// Returns:
+//
+//   - time.Time: the civil date at midnight UTC
+//   - error: the validation error, if the timestamp is nil or invalid
+func FromTimestamp(timestamp *timestamppb.Timestamp, loc *time.Location) (time.Time, error) {
+	if err := timestamp.CheckValid(); err != nil {
+		return time.Time{}, ErrInvalidTimestamp
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	year, month, day := timestamp.AsTime().In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+}
+
+// ToTime converts a birthdate value to a time
+//
+// Parameters:
+//
+//   - value: the birthdate, either a time.Time, a *time.Time, a civil date string in the YYYY-MM-DD format, a
+//     *timestamppb.Timestamp or a civil date message such as google.type.Date
+//   - loc: the timezone of the civil date of the timestamps (optional, if nil UTC is used)
+//
+// Returns:
+//
+//   - time.Time: the birthdate time
+//   - error: the validation error, if the value is invalid or its type is not supported
+func ToTime(value any, loc *time.Location) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, ErrInvalidBirthdate
+		}
+		return *v, nil
+	case string:
+		return ParseCivilDate(v)
+	case *timestamppb.Timestamp:
+		return FromTimestamp(v, loc)
+	case Date:
+		return FromDate(v)
+	}
+	return time.Time{}, ErrUnsupportedType
+}
@@ -0,0 +1,62 @@
+package birthdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseCivilDate(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		want  time.Time
+		err   error
+	}{
+		{value: "2000-06-15", want: date(2000, time.June, 15, time.UTC)},
+		{value: "2024-02-29", want: date(2024, time.February, 29, time.UTC)},
+		{value: "2023-02-29", err: ErrInvalidDate},
+		{value: "2023-13-01", err: ErrInvalidDate},
+		{value: "0000-01-01", err: ErrInvalidDate},
+		{value: "0001-01-01", want: date(1, time.January, 1, time.UTC)},
+		{value: "2000-6-15", err: ErrInvalidDateFormat},
+		{value: "2000/06/15", err: ErrInvalidDateFormat},
+	} {
+		got, err := ParseCivilDate(tt.value)
+		if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("ParseCivilDate(%q) error = %v, want %v", tt.value, err, tt.err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseCivilDate(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFromTimestamp(t *testing.T) {
+	// Midnight of June 15 in UTC+2 is June 14 in UTC
+	instant := time.Date(2000, time.June, 15, 0, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+	timestamp := timestamppb.New(instant)
+
+	for _, tt := range []struct {
+		name string
+		loc  *time.Location
+		want time.Time
+	}{
+		{name: "utc", want: date(2000, time.June, 14, time.UTC)},
+		{name: "offset of the instant", loc: instant.Location(), want: date(2000, time.June, 15, time.UTC)},
+	} {
+		got, err := FromTimestamp(timestamp, tt.loc)
+		if err != nil {
+			t.Fatalf("FromTimestamp(%v) returned error: %v", tt.name, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("FromTimestamp(%v) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := FromTimestamp(nil, nil); !errors.Is(err, ErrInvalidTimestamp) {
+		t.Errorf("FromTimestamp(nil) error = %v, want %v", err, ErrInvalidTimestamp)
+	}
+}
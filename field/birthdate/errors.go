@@ -2,6 +2,8 @@ package birthdate
 
 import (
 	"errors"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
 )
 
 var (
@@ -9,3 +11,44 @@ var (
 	ErrMinimumAge       = "age must be greater than or equal to %d"
 	ErrMaximumAge       = "age must be less than or equal to %d"
 )
+
+const (
+	ErrJurisdictionMinimumAgeMessage  = "age must be greater than or equal to %d in %s"
+	ErrJurisdictionMaximumAgeMessage  = "age must be less than or equal to %d in %s"
+	ErrUnsupportedJurisdictionMessage = "jurisdiction %s is not supported"
+)
+
+var (
+	ErrInvalidDateFormat = govalidatorfield.NewError(
+		"birthdate.invalid_format",
+		"birthdate must be a date in the YYYY-MM-DD format",
+	)
+	ErrInvalidDate = govalidatorfield.NewError(
+		"birthdate.invalid_date",
+		"birthdate is not a valid calendar date",
+	)
+	ErrIncompleteDate = govalidatorfield.NewError(
+		"birthdate.incomplete_date",
+		"birthdate must have a year, a month and a day",
+	)
+	ErrInvalidTimestamp = govalidatorfield.NewError(
+		"birthdate.invalid_timestamp",
+		"birthdate is not a valid timestamp",
+	)
+	ErrUnsupportedType = govalidatorfield.NewError(
+		"birthdate.unsupported_type",
+		"birthdate type is not supported",
+	)
+	ErrJurisdictionMinimumAge = govalidatorfield.NewError(
+		"birthdate.jurisdiction_minimum_age",
+		"age is less than the minimum age of the jurisdiction",
+	)
+	ErrJurisdictionMaximumAge = govalidatorfield.NewError(
+		"birthdate.jurisdiction_maximum_age",
+		"age is greater than the maximum age of the jurisdiction",
+	)
+	ErrUnsupportedJurisdiction = govalidatorfield.NewError(
+		"birthdate.unsupported_jurisdiction",
+		"jurisdiction is not supported",
+	)
+)
@@ -0,0 +1,19 @@
+package birthdate
+
+import (
+	"time"
+)
+
+type (
+	// Clock is an interface to get the current time, so it can be replaced in tests
+	Clock interface {
+		Now() time.Time
+	}
+
+	// Date is an interface for civil date messages, such as google.type.Date
+	Date interface {
+		GetYear() int32
+		GetMonth() int32
+		GetDay() int32
+	}
+)
@@ -0,0 +1,271 @@
+package birthdate
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// JurisdictionMetadataKey is the metadata key of the jurisdiction in the validation errors
+	JurisdictionMetadataKey = "jurisdiction"
+
+	// RuleMetadataKey is the metadata key of the failed age rule in the validation errors
+	RuleMetadataKey = "rule"
+
+	// AgeMetadataKey is the metadata key of the required age in the validation errors
+	AgeMetadataKey = "age"
+)
+
+type (
+	// AgeRule is the age gating rule of a jurisdiction
+	AgeRule struct {
+		// MinimumAge is the minimum age required, if zero it is not checked
+		MinimumAge int `json:"minimum_age,omitempty"`
+
+		// MaximumAge is the maximum age allowed, if zero it is not checked
+		MaximumAge int `json:"maximum_age,omitempty"`
+
+		// Rule is the name of the regulation that defines the rule, e.g. 'COPPA'
+		Rule string `json:"rule,omitempty"`
+	}
+
+	// JurisdictionTable is the table of the age rules, where the key is the jurisdiction code, either an ISO 3166-1
+	// alpha-2 country code or an ISO 3166-2 subdivision code, e.g. 'US' or 'US-CA'
+	JurisdictionTable map[string]AgeRule
+
+	// jurisdictionContextKey is the context key of the jurisdiction code
+	jurisdictionContextKey struct{}
+)
+
+var (
+	//go:embed data/jurisdictions.json
+	jurisdictionsData []byte
+
+	// defaultJurisdictionTable is the parsed bundled jurisdictions table
+	defaultJurisdictionTable     JurisdictionTable
+	defaultJurisdictionTableOnce sync.Once
+)
+
+// LoadJurisdictionTable loads a jurisdictions table from JSON, e.g. '{"US": {"minimum_age": 13, "rule": "COPPA"}}'
+//
+// Parameters:
+//
+//   - reader: the reader of the jurisdictions table
+//
+// Returns:
+//
+//   - JurisdictionTable: the jurisdictions table
+//   - error: if the jurisdictions table could not be read or decoded
+func LoadJurisdictionTable(reader io.Reader) (JurisdictionTable, error) {
+	var table JurisdictionTable
+	if err := json.NewDecoder(reader).Decode(&table); err != nil {
+		return nil, err
+	}
+
+	// Normalize the jurisdiction codes
+	normalizedTable := make(JurisdictionTable, len(table))
+	for code, rule := range table {
+		normalizedTable[NormalizeJurisdiction(code)] = rule
+	}
+	return normalizedTable, nil
+}
+
+// DefaultJurisdictionTable returns a copy of the bundled jurisdictions table, which contains the minimum digital
+// consent ages of COPPA and the GDPR Article 8 member state laws, among others. A new table is returned on each call,
+// so it can be modified by the caller
+//
+// Returns:
+//
+//   - JurisdictionTable: the jurisdictions table
+func DefaultJurisdictionTable() JurisdictionTable {
+	return loadDefaultJurisdictionTable().Merge(nil)
+}
+
+// loadDefaultJurisdictionTable returns the shared bundled jurisdictions table, loading it on the first call. The table
+// must not be modified
+//
+// Returns:
+//
+//   - JurisdictionTable: the jurisdictions table
+func loadDefaultJurisdictionTable() JurisdictionTable {
+	defaultJurisdictionTableOnce.Do(
+		func() {
+			// The bundled table is embedded and well-formed, so it cannot fail to be decoded
+			defaultJurisdictionTable, _ = LoadJurisdictionTable(
+				strings.NewReader(string(jurisdictionsData)),
+			)
+		},
+	)
+	return defaultJurisdictionTable
+}
+
+// NormalizeJurisdiction normalizes a jurisdiction code to uppercase without surrounding spaces
+//
+// Parameters:
+//
+//   - code: the jurisdiction code
+//
+// Returns:
+//
+//   - string: the normalized jurisdiction code
+func NormalizeJurisdiction(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Merge returns a new table with the rules of the table overridden by the given rules
+//
+// Parameters:
+//
+//   - overrides: the rules that override the table rules (optional, can be nil)
+//
+// Returns:
+//
+//   - JurisdictionTable: the merged table
+func (j JurisdictionTable) Merge(overrides JurisdictionTable) JurisdictionTable {
+	merged := make(JurisdictionTable, len(j)+len(overrides))
+	for code, rule := range j {
+		merged[code] = rule
+	}
+	for code, rule := range overrides {
+		merged[NormalizeJurisdiction(code)] = rule
+	}
+	return merged
+}
+
+// Lookup returns the age rule of a jurisdiction, falling back to the country rule for subdivision codes
+//
+// Parameters:
+//
+//   - code: the jurisdiction code
+//
+// Returns:
+//
+//   - AgeRule: the age rule
+//   - string: the code of the jurisdiction the rule was found for
+//   - bool: true if a rule was found, false otherwise
+func (j JurisdictionTable) Lookup(code string) (AgeRule, string, bool) {
+	code = NormalizeJurisdiction(code)
+	if code == "" {
+		return AgeRule{}, "", false
+	}
+
+	// Check the exact jurisdiction code
+	if rule, ok := j[code]; ok {
+		return rule, code, true
+	}
+
+	// Check the country code of a subdivision code
+	if index := strings.Index(code, "-"); index > 0 {
+		country := code[:index]
+		if rule, ok := j[country]; ok {
+			return rule, country, true
+		}
+	}
+	return AgeRule{}, "", false
+}
+
+// WithJurisdiction returns a copy of the context with the jurisdiction code
+//
+// Parameters:
+//
+//   - ctx: the parent context
+//   - code: the jurisdiction code
+//
+// Returns:
+//
+//   - context.Context: the context with the jurisdiction code
+func WithJurisdiction(ctx context.Context, code string) context.Context {
+	return context.WithValue(ctx, jurisdictionContextKey{}, NormalizeJurisdiction(code))
+}
+
+// JurisdictionFromContext returns the jurisdiction code of the context
+//
+// Parameters:
+//
+//   - ctx: the context
+//
+// Returns:
+//
+//   - string: the jurisdiction code
+//   - bool: true if the context has a jurisdiction code, false otherwise
+func JurisdictionFromContext(ctx context.Context) (string, bool) {
+	code, ok := ctx.Value(jurisdictionContextKey{}).(string)
+	return code, ok && code != ""
+}
+
+// ValidateJurisdiction validates a birthdate against the age rule of a jurisdiction. A jurisdiction without a rule in
+// the table is rejected, so it does not pass silently, jurisdictions without age restrictions can be added to the
+// table with an empty rule
+//
+// Parameters:
+//
+//   - birth: the birthdate to validate
+//   - now: the current time
+//   - jurisdiction: the jurisdiction code
+//   - table: the jurisdictions table (optional, if nil the bundled table is used)
+//   - loc: the reference timezone (optional, if nil the timezone of now is used)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the birthdate is valid or the jurisdiction is empty
+func ValidateJurisdiction(
+	birth, now time.Time,
+	jurisdiction string,
+	table JurisdictionTable,
+	loc *time.Location,
+) []error {
+	// Check if the jurisdiction is empty
+	jurisdiction = NormalizeJurisdiction(jurisdiction)
+	if jurisdiction == "" {
+		return nil
+	}
+
+	if table == nil {
+		table = loadDefaultJurisdictionTable()
+	}
+
+	// Get the age rule of the jurisdiction
+	rule, code, ok := table.Lookup(jurisdiction)
+	if !ok {
+		return []error{
+			ErrUnsupportedJurisdiction.
+				WithMessage(fmt.Sprintf(ErrUnsupportedJurisdictionMessage, jurisdiction)).
+				WithMetadata(JurisdictionMetadataKey, jurisdiction),
+		}
+	}
+
+	var errs []error
+
+	// Check if the age is less than the minimum age of the jurisdiction
+	age := Age(birth, now, loc)
+	if rule.MinimumAge > 0 && age < rule.MinimumAge {
+		errs = append(
+			errs,
+			ErrJurisdictionMinimumAge.
+				WithMessage(fmt.Sprintf(ErrJurisdictionMinimumAgeMessage, rule.MinimumAge, code)).
+				WithMetadata(JurisdictionMetadataKey, code).
+				WithMetadata(RuleMetadataKey, rule.Rule).
+				WithMetadata(AgeMetadataKey, strconv.Itoa(rule.MinimumAge)),
+		)
+	}
+
+	// Check if the age is greater than the maximum age of the jurisdiction
+	if rule.MaximumAge > 0 && age > rule.MaximumAge {
+		errs = append(
+			errs,
+			ErrJurisdictionMaximumAge.
+				WithMessage(fmt.Sprintf(ErrJurisdictionMaximumAgeMessage, rule.MaximumAge, code)).
+				WithMetadata(JurisdictionMetadataKey, code).
+				WithMetadata(RuleMetadataKey, rule.Rule).
+				WithMetadata(AgeMetadataKey, strconv.Itoa(rule.MaximumAge)),
+		)
+	}
+	return errs
+}
@@ -0,0 +1,60 @@
+package birthdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateJurisdiction(t *testing.T) {
+	now := date(2018, time.June, 15, time.UTC)
+	for _, tt := range []struct {
+		name         string
+		birth        time.Time
+		jurisdiction string
+		table        JurisdictionTable
+		want         error
+	}{
+		{name: "empty jurisdiction", birth: date(2010, time.June, 15, time.UTC)},
+		{name: "above minimum age", birth: date(2000, time.June, 15, time.UTC), jurisdiction: "US"},
+		{
+			name:         "below minimum age",
+			birth:        date(2010, time.June, 15, time.UTC),
+			jurisdiction: "us",
+			want:         ErrJurisdictionMinimumAge,
+		},
+		{
+			name:         "subdivision falls back to country",
+			birth:        date(2010, time.June, 15, time.UTC),
+			jurisdiction: "US-CA",
+			want:         ErrJurisdictionMinimumAge,
+		},
+		{
+			name:         "unsupported jurisdiction",
+			birth:        date(2000, time.June, 15, time.UTC),
+			jurisdiction: "ZZ",
+			want:         ErrUnsupportedJurisdiction,
+		},
+		{
+			name:         "jurisdiction without age restrictions",
+			birth:        date(2010, time.June, 15, time.UTC),
+			jurisdiction: "ZZ",
+			table:        DefaultJurisdictionTable().Merge(JurisdictionTable{"zz": {}}),
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := ValidateJurisdiction(tt.birth, now, tt.jurisdiction, tt.table, nil)
+				if tt.want == nil {
+					if len(errs) != 0 {
+						t.Errorf("ValidateJurisdiction = %v, want nil", errs)
+					}
+					return
+				}
+				if len(errs) != 1 || !errors.Is(errs[0], tt.want) {
+					t.Errorf("ValidateJurisdiction = %v, want [%v]", errs, tt.want)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,67 @@
+package birthdate
+
+import (
+	"time"
+)
+
+type (
+	// Options is the birthdate options struct
+	Options struct {
+		MinimumAge int
+		MaximumAge int
+
+		// Location is the reference timezone used to get the current date, if nil the clock timezone is used
+		Location *time.Location
+
+		// Jurisdictions is the table of the age rules by jurisdiction, if nil the bundled table is used
+		Jurisdictions JurisdictionTable
+	}
+
+	// SystemClock is the Clock implementation that returns the system time
+	SystemClock struct{}
+
+	// FixedClock is the Clock implementation that always returns the same time
+	FixedClock struct {
+		now time.Time
+	}
+)
+
+// NewSystemClock creates a new system clock
+//
+// Returns:
+//
+//   - SystemClock: the system clock
+func NewSystemClock() SystemClock {
+	return SystemClock{}
+}
+
+// Now returns the current system time
+//
+// Returns:
+//
+//   - time.Time: the current time
+func (s SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewFixedClock creates a new fixed clock
+//
+// Parameters:
+//
+//   - now: the time returned by the clock
+//
+// Returns:
+//
+//   - FixedClock: the fixed clock
+func NewFixedClock(now time.Time) FixedClock {
+	return FixedClock{now: now}
+}
+
+// Now returns the fixed time
+//
+// Returns:
+//
+//   - time.Time: the fixed time
+func (f FixedClock) Now() time.Time {
+	return f.now
+}
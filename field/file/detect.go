@@ -0,0 +1,111 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/http"
+	"strings"
+)
+
+type (
+	// magicNumber is the signature of a media type at an offset of the content
+	magicNumber struct {
+		offset    int
+		signature string
+		mediaType string
+	}
+)
+
+var (
+	// magicNumbers are the signatures of the common media types that are not detected by http.DetectContentType
+	magicNumbers = []magicNumber{
+		{offset: 0, signature: "II*\x00", mediaType: "image/tiff"},
+		{offset: 0, signature: "MM\x00*", mediaType: "image/tiff"},
+		{offset: 4, signature: "ftypavif", mediaType: "image/avif"},
+		{offset: 4, signature: "ftypheic", mediaType: "image/heic"},
+		{offset: 4, signature: "ftypheix", mediaType: "image/heic"},
+		{offset: 4, signature: "ftypmif1", mediaType: "image/heif"},
+		{offset: 0, signature: "7z\xBC\xAF\x27\x1C", mediaType: "application/x-7z-compressed"},
+		{offset: 0, signature: "BZh", mediaType: "application/x-bzip2"},
+		{offset: 0, signature: "\xFD7zXZ\x00", mediaType: "application/x-xz"},
+		{offset: 0, signature: "\x28\xB5\x2F\xFD", mediaType: "application/zstd"},
+		{offset: 0, signature: "\x7FELF", mediaType: "application/x-elf"},
+		{offset: 0, signature: "SQLite format 3\x00", mediaType: "application/vnd.sqlite3"},
+	}
+)
+
+const (
+	// portableExecutableMediaType is the media type of the Windows executables
+	portableExecutableMediaType = "application/vnd.microsoft.portable-executable"
+
+	// portableExecutableHeaderOffset is the offset of the little-endian offset of the PE header in the DOS header
+	portableExecutableHeaderOffset = 0x3C
+)
+
+// DetectContentType detects the media type of the content from its leading bytes, checking the magic numbers of the
+// common formats not known by http.DetectContentType, such as TIFF, AVIF, HEIC, 7z, executables and SVG, before
+// falling back to it
+//
+// Parameters:
+//
+//   - data: the content, only the first SniffLength bytes are considered
+//
+// Returns:
+//
+//   - string: the media type without parameters, 'application/octet-stream' if it is unknown
+func DetectContentType(data []byte) string {
+	if len(data) > SniffLength {
+		data = data[:SniffLength]
+	}
+
+	// Check the magic numbers
+	for _, magic := range magicNumbers {
+		if len(data) >= magic.offset+len(magic.signature) &&
+			string(data[magic.offset:magic.offset+len(magic.signature)]) == magic.signature {
+			return magic.mediaType
+		}
+	}
+	if isPortableExecutable(data) {
+		return portableExecutableMediaType
+	}
+
+	// Check the SVG images, which are sniffed as XML or plain text
+	mediaType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	if (mediaType == "text/xml" || mediaType == "text/plain") && isSVG(data) {
+		return "image/svg+xml"
+	}
+	return mediaType
+}
+
+// isSVG checks if the leading bytes of a XML document contain a SVG root element
+//
+// Parameters:
+//
+//   - data: the leading bytes
+//
+// Returns:
+//
+//   - bool: true if the content looks like a SVG image, false otherwise
+func isSVG(data []byte) bool {
+	return bytes.Contains(bytes.ToLower(data), []byte("<svg"))
+}
+
+// isPortableExecutable checks if the leading bytes are a DOS header pointing to a PE header, as the 'MZ' signature
+// alone is too short and matches plain text
+//
+// Parameters:
+//
+//   - data: the leading bytes
+//
+// Returns:
+//
+//   - bool: true if the content is a Windows executable, false otherwise
+func isPortableExecutable(data []byte) bool {
+	if len(data) < portableExecutableHeaderOffset+4 || string(data[:2]) != "MZ" {
+		return false
+	}
+
+	// Check the PE signature at the offset read from the DOS header
+	headerOffset := uint64(binary.LittleEndian.Uint32(data[portableExecutableHeaderOffset:]))
+	return headerOffset+4 <= uint64(len(data)) && string(data[headerOffset:headerOffset+4]) == "PE\x00\x00"
+}
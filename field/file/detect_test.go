@@ -0,0 +1,34 @@
+package file
+
+import "testing"
+
+func TestDetectContentTypePortableExecutable(t *testing.T) {
+	executable := make([]byte, 256)
+	copy(executable, "MZ")
+	executable[portableExecutableHeaderOffset] = 0x80
+	copy(executable[0x80:], "PE\x00\x00")
+
+	truncated := make([]byte, 256)
+	copy(truncated, "MZ")
+	truncated[portableExecutableHeaderOffset] = 0xFF
+	truncated[portableExecutableHeaderOffset+1] = 0xFF
+
+	for _, tt := range []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{name: "executable", data: executable, want: portableExecutableMediaType},
+		{name: "header out of range", data: truncated, want: "application/octet-stream"},
+		{
+			name: "text",
+			data: []byte("MZ is the state code, which is long enough to hold a PE header offset"),
+			want: "text/plain",
+		},
+		{name: "short text", data: []byte("MZ"), want: "text/plain"},
+	} {
+		if got := DetectContentType(tt.data); got != tt.want {
+			t.Errorf("DetectContentType(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
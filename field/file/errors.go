@@ -0,0 +1,52 @@
+package file
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	ErrTooLargeMessage            = "file must be at most %d bytes"
+	ErrExtensionNotAllowedMessage = "file extension %q is not allowed"
+	ErrTypeNotAllowedMessage      = "file type %s is not allowed"
+	ErrDimensionsTooLargeMessage  = "image must be at most %dx%d pixels"
+	ErrDimensionsTooSmallMessage  = "image must be at least %dx%d pixels"
+)
+
+var (
+	ErrMissingFile = govalidatorfield.NewError(
+		"file.missing",
+		"file is required",
+	)
+	ErrUnsupportedType = govalidatorfield.NewError(
+		"file.unsupported_type",
+		"file type is not supported, it must be a multipart file header or a byte slice",
+	)
+	ErrUnreadable = govalidatorfield.NewError(
+		"file.unreadable",
+		"file could not be read",
+	)
+	ErrTooLarge = govalidatorfield.NewError(
+		"file.too_large",
+		"file is too large",
+	)
+	ErrExtensionNotAllowed = govalidatorfield.NewError(
+		"file.extension_not_allowed",
+		"file extension is not allowed",
+	)
+	ErrTypeNotAllowed = govalidatorfield.NewError(
+		"file.type_not_allowed",
+		"file type is not allowed",
+	)
+	ErrInvalidImage = govalidatorfield.NewError(
+		"file.invalid_image",
+		"image header is not valid",
+	)
+	ErrDimensionsTooLarge = govalidatorfield.NewError(
+		"file.dimensions_too_large",
+		"image dimensions are too large",
+	)
+	ErrDimensionsTooSmall = govalidatorfield.NewError(
+		"file.dimensions_too_small",
+		"image dimensions are too small",
+	)
+)
@@ -0,0 +1,45 @@
+package file
+
+type (
+	// Options is the file upload policy struct, where the zero limits and the empty allowlists are not checked
+	Options struct {
+		// MaximumSize is the maximum size of the file in bytes
+		MaximumSize int64
+
+		// AllowedExtensions are the allowed file name extensions, case-insensitive and with the leading dot, e.g.
+		// '.png'. They are not checked for byte slices, which have no file name
+		AllowedExtensions []string
+
+		// AllowedTypes are the allowed media types sniffed from the content, where the subtype can be a wildcard,
+		// e.g. 'image/*'
+		AllowedTypes []string
+
+		// MinimumWidth and MinimumHeight are the minimum dimensions of the PNG, JPEG and GIF images in pixels
+		MinimumWidth  int
+		MinimumHeight int
+
+		// MaximumWidth and MaximumHeight are the maximum dimensions of the PNG, JPEG and GIF images in pixels
+		MaximumWidth  int
+		MaximumHeight int
+	}
+)
+
+const (
+	// SniffLength is the number of leading bytes used to detect the media type of the content
+	SniffLength = 512
+
+	// LimitMetadataKey is the metadata key of the violated limit in the validation errors
+	LimitMetadataKey = "limit"
+
+	// TypeMetadataKey is the metadata key of the detected media type in the validation errors
+	TypeMetadataKey = "type"
+)
+
+// hasDimensions checks if any image dimension limit is set
+//
+// Returns:
+//
+//   - bool: true if any dimension limit is set, false otherwise
+func (o *Options) hasDimensions() bool {
+	return o.MinimumWidth > 0 || o.MinimumHeight > 0 || o.MaximumWidth > 0 || o.MaximumHeight > 0
+}
@@ -0,0 +1,255 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register the GIF header decoder
+	_ "image/jpeg" // Register the JPEG header decoder
+	_ "image/png"  // Register the PNG header decoder
+	"io"
+	"mime/multipart"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	govalidatorfieldformat "github.com/ralvarezdev/go-validator/field/format"
+)
+
+var (
+	// imageTypes are the media types whose dimensions are read from their headers
+	imageTypes = map[string]struct{}{
+		"image/png":  {},
+		"image/jpeg": {},
+		"image/gif":  {},
+	}
+)
+
+// Validate validates a file against the file upload policy
+//
+// Parameters:
+//
+//   - file: the file, either a *multipart.FileHeader, a []*multipart.FileHeader or a []byte
+//   - options: the file upload policy (optional, if nil any file is valid)
+//
+// Returns:
+//
+//   - []error: the policy violations, nil if the file is valid
+func Validate(file any, options *Options) []error {
+	switch typedFile := file.(type) {
+	case *multipart.FileHeader:
+		return ValidateFileHeader(typedFile, options)
+	case multipart.FileHeader:
+		return ValidateFileHeader(&typedFile, options)
+	case []*multipart.FileHeader:
+		var errs []error
+		for _, fileHeader := range typedFile {
+			errs = append(errs, ValidateFileHeader(fileHeader, options)...)
+		}
+		return errs
+	case []byte:
+		return ValidateBytes(typedFile, options)
+	}
+
+	// Check the named byte slice types
+	reflectedFile := reflect.ValueOf(file)
+	if reflectedFile.Kind() == reflect.Slice && reflectedFile.Type().Elem().Kind() == reflect.Uint8 {
+		return ValidateBytes(reflectedFile.Bytes(), options)
+	}
+	return []error{ErrUnsupportedType}
+}
+
+// ValidateFileHeader validates an uploaded multipart file against the file upload policy. The size and the extension
+// are checked from the header, and the content is only read when the media type or the image dimensions are checked
+//
+// Parameters:
+//
+//   - fileHeader: the multipart file header
+//   - options: the file upload policy (optional, if nil any file is valid)
+//
+// Returns:
+//
+//   - []error: the policy violations, nil if the file is valid
+func ValidateFileHeader(fileHeader *multipart.FileHeader, options *Options) []error {
+	if fileHeader == nil {
+		return []error{ErrMissingFile}
+	}
+	if options == nil {
+		return nil
+	}
+
+	var errs []error
+
+	// Check the size of the file
+	if options.MaximumSize > 0 && fileHeader.Size > options.MaximumSize {
+		errs = append(errs, newTooLargeError(options.MaximumSize))
+	}
+
+	// Check the extension of the file name
+	if len(options.AllowedExtensions) > 0 {
+		extension := strings.ToLower(filepath.Ext(fileHeader.Filename))
+		if !isExtensionAllowed(extension, options.AllowedExtensions) {
+			errs = append(
+				errs,
+				ErrExtensionNotAllowed.WithMessage(fmt.Sprintf(ErrExtensionNotAllowedMessage, extension)),
+			)
+		}
+	}
+
+	// Check the content of the file
+	if len(options.AllowedTypes) == 0 && !options.hasDimensions() {
+		return errs
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return append(errs, ErrUnreadable)
+	}
+	defer file.Close()
+	return append(errs, validateContent(file, options)...)
+}
+
+// ValidateBytes validates the content of a file against the file upload policy, the allowed extensions are not
+// checked since the content has no file name
+//
+// Parameters:
+//
+//   - data: the content of the file
+//   - options: the file upload policy (optional, if nil any content is valid)
+//
+// Returns:
+//
+//   - []error: the policy violations, nil if the content is valid
+func ValidateBytes(data []byte, options *Options) []error {
+	if options == nil {
+		return nil
+	}
+
+	var errs []error
+
+	// Check the size of the content
+	if options.MaximumSize > 0 && int64(len(data)) > options.MaximumSize {
+		errs = append(errs, newTooLargeError(options.MaximumSize))
+	}
+
+	// Check the content
+	if len(options.AllowedTypes) == 0 && !options.hasDimensions() {
+		return errs
+	}
+	return append(errs, validateContent(bytes.NewReader(data), options)...)
+}
+
+// NewRule creates a field rule that validates a file field against the file upload policy, which can be added to a
+// mapper field, e.g. mapper.AddFieldRule("Avatar", file.NewRule(options))
+//
+// Parameters:
+//
+//   - options: the file upload policy (optional, if nil any file is valid)
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func NewRule(options *Options) func(fieldValue any) []error {
+	return func(fieldValue any) []error {
+		return Validate(fieldValue, options)
+	}
+}
+
+// validateContent validates the media type and the image dimensions of the content
+//
+// Parameters:
+//
+//   - reader: the reader of the content
+//   - options: the file upload policy
+//
+// Returns:
+//
+//   - []error: the policy violations, nil if the content is valid
+func validateContent(reader io.Reader, options *Options) []error {
+	// Read the leading bytes of the content
+	head := make([]byte, SniffLength)
+	n, err := io.ReadFull(reader, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return []error{ErrUnreadable}
+	}
+	head = head[:n]
+
+	var errs []error
+
+	// Check the media type of the content
+	mediaType := DetectContentType(head)
+	if len(options.AllowedTypes) > 0 && !govalidatorfieldformat.IsMIMETypeAllowed(mediaType, options.AllowedTypes) {
+		errs = append(
+			errs,
+			ErrTypeNotAllowed.
+				WithMessage(fmt.Sprintf(ErrTypeNotAllowedMessage, mediaType)).
+				WithMetadata(TypeMetadataKey, mediaType),
+		)
+	}
+
+	// Check the dimensions of the images, only their headers are decoded
+	if _, isImage := imageTypes[mediaType]; !isImage || !options.hasDimensions() {
+		return errs
+	}
+	config, _, err := image.DecodeConfig(io.MultiReader(bytes.NewReader(head), reader))
+	if err != nil {
+		return append(errs, ErrInvalidImage)
+	}
+	if (options.MaximumWidth > 0 && config.Width > options.MaximumWidth) ||
+		(options.MaximumHeight > 0 && config.Height > options.MaximumHeight) {
+		limit := fmt.Sprintf("%dx%d", options.MaximumWidth, options.MaximumHeight)
+		errs = append(
+			errs,
+			ErrDimensionsTooLarge.
+				WithMessage(fmt.Sprintf(ErrDimensionsTooLargeMessage, options.MaximumWidth, options.MaximumHeight)).
+				WithMetadata(LimitMetadataKey, limit),
+		)
+	}
+	if config.Width < options.MinimumWidth || config.Height < options.MinimumHeight {
+		limit := fmt.Sprintf("%dx%d", options.MinimumWidth, options.MinimumHeight)
+		errs = append(
+			errs,
+			ErrDimensionsTooSmall.
+				WithMessage(fmt.Sprintf(ErrDimensionsTooSmallMessage, options.MinimumWidth, options.MinimumHeight)).
+				WithMetadata(LimitMetadataKey, limit),
+		)
+	}
+	return errs
+}
+
+// newTooLargeError creates the validation error of a file larger than the maximum size
+//
+// Parameters:
+//
+//   - maximumSize: the maximum size in bytes
+//
+// Returns:
+//
+//   - error: the validation error
+func newTooLargeError(maximumSize int64) error {
+	return ErrTooLarge.
+		WithMessage(fmt.Sprintf(ErrTooLargeMessage, maximumSize)).
+		WithMetadata(LimitMetadataKey, strconv.FormatInt(maximumSize, 10))
+}
+
+// isExtensionAllowed checks if a file name extension is in the allowlist
+//
+// Parameters:
+//
+//   - extension: the lowercase extension, with the leading dot
+//   - allowedExtensions: the allowed extensions
+//
+// Returns:
+//
+//   - bool: true if the extension is allowed, false otherwise
+func isExtensionAllowed(extension string, allowedExtensions []string) bool {
+	if extension == "" {
+		return false
+	}
+	for _, allowedExtension := range allowedExtensions {
+		if strings.EqualFold(extension, strings.TrimSpace(allowedExtension)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package finance
+
+import (
+	"strings"
+)
+
+// ValidateBIC validates the format of a SWIFT BIC, as defined by ISO 9362, e.g. 'DEUTDEFF' or 'DEUTDEFF500'
+//
+// Parameters:
+//
+//   - bic: the BIC to validate, case-insensitive
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the BIC is valid
+func ValidateBIC(bic string) []error {
+	bic = strings.ToUpper(strings.TrimSpace(bic))
+	if len(bic) != 8 && len(bic) != 11 {
+		return []error{ErrInvalidBIC}
+	}
+
+	// Check the bank code, the country code, the location code and the branch code
+	if !isUpperAlpha(bic[:6]) || !isUpperAlphanumeric(bic[6:]) {
+		return []error{ErrInvalidBIC}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package finance
+
+import "testing"
+
+func TestValidateBIC(t *testing.T) {
+	for _, tt := range []struct {
+		bic   string
+		valid bool
+	}{
+		{bic: "DEUTDEFF", valid: true},
+		{bic: "deutdeff500", valid: true},
+		{bic: "NEDSZAJJXXX", valid: true},
+		{bic: "DEUTDEF"},
+		{bic: "DEUTDEFF5"},
+		{bic: "DEU1DEFF"},
+		{bic: "DEUTDEFF50!"},
+	} {
+		if errs := ValidateBIC(tt.bic); (len(errs) == 0) != tt.valid {
+			t.Errorf("ValidateBIC(%q) = %v, want valid: %v", tt.bic, errs, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+package finance
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type (
+	// CardBrands is the table of the card brand ranges
+	CardBrands struct {
+		ranges []cardBrandRange
+	}
+
+	// cardBrandRange is an issuer identification number range of a card brand
+	cardBrandRange struct {
+		brand   CardBrand
+		start   int
+		end     int
+		length  int
+		lengths map[int]struct{}
+	}
+)
+
+var (
+	//go:embed data/card_brands.txt
+	cardBrandsData string
+
+	// defaultCardBrands is the parsed bundled card brands table
+	defaultCardBrands     *CardBrands
+	defaultCardBrandsOnce sync.Once
+)
+
+// LoadCardBrands loads a card brands table, with one brand per line followed by its comma-separated prefix ranges and
+// lengths, e.g. 'mastercard 51-55,2221-2720 16'. Empty lines and lines starting with '#' are ignored
+//
+// Parameters:
+//
+//   - reader: the reader of the card brands table
+//
+// Returns:
+//
+//   - *CardBrands: the card brands table
+//   - error: if the table could not be read or parsed
+func LoadCardBrands(reader io.Reader) (*CardBrands, error) {
+	c := &CardBrands{}
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Parse the brand, its prefix ranges and its lengths
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, ErrInvalidCardBrandsData
+		}
+		lengths := make(map[int]struct{})
+		for _, lengthRange := range strings.Split(fields[2], ",") {
+			start, end, err := parseRange(lengthRange)
+			if err != nil {
+				return nil, ErrInvalidCardBrandsData
+			}
+			for length := start; length <= end; length++ {
+				lengths[length] = struct{}{}
+			}
+		}
+		for _, prefixRange := range strings.Split(fields[1], ",") {
+			start, end, err := parseRange(prefixRange)
+			if err != nil || len(strconv.Itoa(start)) != len(strconv.Itoa(end)) {
+				return nil, ErrInvalidCardBrandsData
+			}
+			c.ranges = append(
+				c.ranges, cardBrandRange{
+					brand:   CardBrand(fields[0]),
+					start:   start,
+					end:     end,
+					length:  len(strconv.Itoa(start)),
+					lengths: lengths,
+				},
+			)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DefaultCardBrands returns the bundled card brands table
+//
+// Returns:
+//
+//   - *CardBrands: the card brands table
+func DefaultCardBrands() *CardBrands {
+	defaultCardBrandsOnce.Do(
+		func() {
+			// The bundled table is embedded and well-formed, so it cannot fail to be parsed
+			defaultCardBrands, _ = LoadCardBrands(strings.NewReader(cardBrandsData))
+		},
+	)
+	return defaultCardBrands
+}
+
+// Detect returns the brand of a card number, based on its longest matching prefix range
+//
+// Parameters:
+//
+//   - digits: the digits of the card number
+//
+// Returns:
+//
+//   - CardBrand: the card brand
+//   - bool: true if the card number matches a brand with its length, false otherwise
+//   - bool: true if the card number prefix matches a brand, false otherwise
+func (c *CardBrands) Detect(digits string) (CardBrand, bool, bool) {
+	if c == nil {
+		return "", false, false
+	}
+
+	var bestRange *cardBrandRange
+	for i := range c.ranges {
+		brandRange := &c.ranges[i]
+		if len(digits) < brandRange.length || (bestRange != nil && brandRange.length <= bestRange.length) {
+			continue
+		}
+		prefix, err := strconv.Atoi(digits[:brandRange.length])
+		if err != nil || prefix < brandRange.start || prefix > brandRange.end {
+			continue
+		}
+		bestRange = brandRange
+	}
+	if bestRange == nil {
+		return "", false, false
+	}
+	_, isValidLength := bestRange.lengths[len(digits)]
+	return bestRange.brand, isValidLength, true
+}
+
+// DetectCardBrand returns the brand of a card number using the bundled card brands table
+//
+// Parameters:
+//
+//   - number: the card number, it may contain spaces and hyphens
+//
+// Returns:
+//
+//   - CardBrand: the card brand
+//   - bool: true if the card number belongs to a known brand, false otherwise
+func DetectCardBrand(number string) (CardBrand, bool) {
+	digits, ok := cardDigits(number)
+	if !ok {
+		return "", false
+	}
+	brand, isValidLength, ok := DefaultCardBrands().Detect(digits)
+	return brand, ok && isValidLength
+}
+
+// ValidateCard validates a card number with the Luhn check and the card brand ranges
+//
+// Parameters:
+//
+//   - number: the card number to validate, it may contain spaces and hyphens
+//   - options: the card number validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the card number is valid
+func ValidateCard(number string, options *CardOptions) []error {
+	if options == nil {
+		options = &CardOptions{}
+	}
+	brands := options.Brands
+	if brands == nil {
+		brands = DefaultCardBrands()
+	}
+
+	digits, ok := cardDigits(number)
+	if !ok {
+		return []error{ErrInvalidCardNumber}
+	}
+	if len(digits) < MinimumCardLength || len(digits) > MaximumCardLength {
+		return []error{ErrInvalidCardLength}
+	}
+
+	var errs []error
+
+	// Check the check digit
+	if !IsLuhnValid(digits) {
+		errs = append(errs, ErrInvalidCardChecksum)
+	}
+
+	// Check the card brand
+	brand, isValidLength, ok := brands.Detect(digits)
+	switch {
+	case !ok:
+		if len(options.AllowedBrands) > 0 {
+			errs = append(errs, ErrUnknownCardBrand)
+		}
+	case !isValidLength:
+		errs = append(errs, ErrInvalidCardLength)
+	case len(options.AllowedBrands) > 0:
+		isAllowed := false
+		for _, allowedBrand := range options.AllowedBrands {
+			if allowedBrand == brand {
+				isAllowed = true
+				break
+			}
+		}
+		if !isAllowed {
+			errs = append(errs, ErrCardBrandNotAllowed)
+		}
+	}
+	return errs
+}
+
+// cardDigits removes the spaces and hyphens of a card number
+//
+// Parameters:
+//
+//   - number: the card number
+//
+// Returns:
+//
+//   - string: the digits of the card number
+//   - bool: true if the card number only contains digits, spaces and hyphens, false otherwise
+func cardDigits(number string) (string, bool) {
+	var builder strings.Builder
+	for _, r := range strings.TrimSpace(number) {
+		switch {
+		case r >= '0' && r <= '9':
+			builder.WriteRune(r)
+		case r == ' ' || r == '-':
+		default:
+			return "", false
+		}
+	}
+	return builder.String(), builder.Len() > 0
+}
+
+// parseRange parses an inclusive range of non-negative numbers, e.g. '51-55' or '4'
+//
+// Parameters:
+//
+//   - s: the range to parse
+//
+// Returns:
+//
+//   - int: the start of the range
+//   - int: the end of the range
+//   - error: if the range is not valid
+func parseRange(s string) (int, int, error) {
+	startStr, endStr, isRange := strings.Cut(s, "-")
+	start, err := strconv.Atoi(startStr)
+	if err != nil || start < 0 {
+		return 0, 0, ErrInvalidCardBrandsData
+	}
+	if !isRange {
+		return start, start, nil
+	}
+	end, err := strconv.Atoi(endStr)
+	if err != nil || end < start {
+		return 0, 0, ErrInvalidCardBrandsData
+	}
+	return start, end, nil
+}
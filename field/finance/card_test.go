@@ -0,0 +1,75 @@
+package finance
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDetectCardBrand(t *testing.T) {
+	for _, tt := range []struct {
+		number string
+		want   CardBrand
+		ok     bool
+	}{
+		{number: "4111 1111 1111 1111", want: CardBrandVisa, ok: true},
+		{number: "5555555555554444", want: CardBrandMastercard, ok: true},
+		{number: "2223003122003222", want: CardBrandMastercard, ok: true},
+		{number: "378282246310005", want: CardBrandAmex, ok: true},
+		{number: "6011111111111117", want: CardBrandDiscover, ok: true},
+		{number: "30569309025904", want: CardBrandDinersClub, ok: true},
+		{number: "3530111333300000", want: CardBrandJCB, ok: true},
+		{number: "6200000000000005", want: CardBrandUnionPay, ok: true},
+		{number: "2200000000000004", want: CardBrandMir, ok: true},
+		{number: "37828224631000", want: CardBrandAmex},
+		{number: "9999999999999995"},
+		{number: "4111-1111-1111-111x"},
+	} {
+		got, ok := DetectCardBrand(tt.number)
+		if ok != tt.ok || (ok && got != tt.want) {
+			t.Errorf("DetectCardBrand(%q) = %q, %v, want %q, %v", tt.number, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestValidateCard(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		number  string
+		options *CardOptions
+		want    []error
+	}{
+		{name: "valid", number: "4111-1111-1111-1111"},
+		{name: "invalid characters", number: "4111.1111.1111.1111", want: []error{ErrInvalidCardNumber}},
+		{name: "too short", number: "41111111111", want: []error{ErrInvalidCardLength}},
+		{name: "too long", number: "41111111111111111111", want: []error{ErrInvalidCardLength}},
+		{name: "invalid check digit", number: "4111111111111112", want: []error{ErrInvalidCardChecksum}},
+		{name: "invalid brand length", number: "41111111111114", want: []error{ErrInvalidCardLength}},
+		{name: "unknown brand", number: "9999999999999995"},
+		{
+			name:    "unknown brand with allowed brands",
+			number:  "9999999999999995",
+			options: &CardOptions{AllowedBrands: []CardBrand{CardBrandVisa}},
+			want:    []error{ErrUnknownCardBrand},
+		},
+		{
+			name:    "brand not allowed",
+			number:  "5555555555554444",
+			options: &CardOptions{AllowedBrands: []CardBrand{CardBrandVisa}},
+			want:    []error{ErrCardBrandNotAllowed},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := ValidateCard(tt.number, tt.options)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("ValidateCard(%q) = %v, want %v", tt.number, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("ValidateCard(%q) = %v, want %v", tt.number, errs, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
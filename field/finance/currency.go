@@ -0,0 +1,107 @@
+package finance
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	//go:embed data/currencies.txt
+	currenciesData string
+
+	// defaultCurrencies is the parsed bundled currencies table
+	defaultCurrencies     map[string]Currency
+	defaultCurrenciesOnce sync.Once
+)
+
+// LoadCurrencies loads a currencies table, with one currency per line followed by its numeric code and its minor
+// units, e.g. 'USD 840 2'. Empty lines and lines starting with '#' are ignored
+//
+// Parameters:
+//
+//   - reader: the reader of the currencies table
+//
+// Returns:
+//
+//   - map[string]Currency: the currencies by alphabetic code
+//   - error: if the table could not be read or parsed
+func LoadCurrencies(reader io.Reader) (map[string]Currency, error) {
+	currencies := make(map[string]Currency)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 || len(fields[0]) != 3 || len(fields[1]) != 3 {
+			return nil, ErrInvalidCurrenciesData
+		}
+		minorUnits, err := strconv.Atoi(fields[2])
+		if err != nil || minorUnits < 0 {
+			return nil, ErrInvalidCurrenciesData
+		}
+		code := strings.ToUpper(fields[0])
+		currencies[code] = Currency{
+			Code:       code,
+			Numeric:    fields[1],
+			MinorUnits: minorUnits,
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return currencies, nil
+}
+
+// DefaultCurrencies returns the bundled ISO 4217 active currencies table
+//
+// Returns:
+//
+//   - map[string]Currency: the currencies by alphabetic code
+func DefaultCurrencies() map[string]Currency {
+	defaultCurrenciesOnce.Do(
+		func() {
+			// The bundled table is embedded and well-formed, so it cannot fail to be parsed
+			defaultCurrencies, _ = LoadCurrencies(strings.NewReader(currenciesData))
+		},
+	)
+	return defaultCurrencies
+}
+
+// LookupCurrency returns an active ISO 4217 currency by its alphabetic code
+//
+// Parameters:
+//
+//   - code: the alphabetic code of the currency, in uppercase
+//
+// Returns:
+//
+//   - Currency: the currency
+//   - bool: true if the currency exists, false otherwise
+func LookupCurrency(code string) (Currency, bool) {
+	currency, ok := DefaultCurrencies()[code]
+	return currency, ok
+}
+
+// ValidateCurrency validates an ISO 4217 alphabetic currency code, which must be in uppercase
+//
+// Parameters:
+//
+//   - code: the currency code to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the currency code is valid
+func ValidateCurrency(code string) []error {
+	if _, ok := LookupCurrency(code); !ok {
+		return []error{ErrInvalidCurrency}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package finance
+
+import (
+	"errors"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+var (
+	ErrInvalidCardBrandsData  = errors.New("invalid card brands data")
+	ErrInvalidIBANLengthsData = errors.New("invalid IBAN lengths data")
+	ErrInvalidCurrenciesData  = errors.New("invalid currencies data")
+)
+
+var (
+	ErrInvalidCardNumber = govalidatorfield.NewError(
+		"finance.invalid_card_number",
+		"card number must only contain digits, spaces and hyphens",
+	)
+	ErrInvalidCardLength = govalidatorfield.NewError(
+		"finance.invalid_card_length",
+		"card number length is not valid for its brand",
+	)
+	ErrInvalidCardChecksum = govalidatorfield.NewError(
+		"finance.invalid_card_checksum",
+		"card number check digit is not valid",
+	)
+	ErrUnknownCardBrand = govalidatorfield.NewError(
+		"finance.unknown_card_brand",
+		"card number does not belong to a known card brand",
+	)
+	ErrCardBrandNotAllowed = govalidatorfield.NewError(
+		"finance.card_brand_not_allowed",
+		"card brand is not allowed",
+	)
+	ErrInvalidIBANFormat = govalidatorfield.NewError(
+		"finance.invalid_iban_format",
+		"IBAN must start with a country code and two check digits, followed by letters and digits",
+	)
+	ErrUnsupportedIBANCountry = govalidatorfield.NewError(
+		"finance.unsupported_iban_country",
+		"IBAN country does not use IBANs",
+	)
+	ErrInvalidIBANLength = govalidatorfield.NewError(
+		"finance.invalid_iban_length",
+		"IBAN length is not valid for its country",
+	)
+	ErrInvalidIBANChecksum = govalidatorfield.NewError(
+		"finance.invalid_iban_checksum",
+		"IBAN check digits are not valid",
+	)
+	ErrInvalidBIC = govalidatorfield.NewError(
+		"finance.invalid_bic",
+		"BIC must have a bank code, a country code, a location code and an optional branch code",
+	)
+	ErrInvalidCurrency = govalidatorfield.NewError(
+		"finance.invalid_currency",
+		"currency must be an active ISO 4217 currency code",
+	)
+)
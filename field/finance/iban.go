@@ -0,0 +1,181 @@
+package finance
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	//go:embed data/iban_lengths.txt
+	ibanLengthsData string
+
+	// defaultIBANLengths is the parsed bundled IBAN lengths table
+	defaultIBANLengths     map[string]int
+	defaultIBANLengthsOnce sync.Once
+)
+
+// LoadIBANLengths loads an IBAN lengths table, with one country code and its IBAN length per line, e.g. 'ES 24'.
+// Empty lines and lines starting with '#' are ignored
+//
+// Parameters:
+//
+//   - reader: the reader of the IBAN lengths table
+//
+// Returns:
+//
+//   - map[string]int: the IBAN lengths by country code
+//   - error: if the table could not be read or parsed
+func LoadIBANLengths(reader io.Reader) (map[string]int, error) {
+	lengths := make(map[string]int)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 || len(fields[0]) != 2 {
+			return nil, ErrInvalidIBANLengthsData
+		}
+		length, err := strconv.Atoi(fields[1])
+		if err != nil || length <= 4 {
+			return nil, ErrInvalidIBANLengthsData
+		}
+		lengths[strings.ToUpper(fields[0])] = length
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lengths, nil
+}
+
+// DefaultIBANLengths returns the bundled IBAN lengths table
+//
+// Returns:
+//
+//   - map[string]int: the IBAN lengths by country code
+func DefaultIBANLengths() map[string]int {
+	defaultIBANLengthsOnce.Do(
+		func() {
+			// The bundled table is embedded and well-formed, so it cannot fail to be parsed
+			defaultIBANLengths, _ = LoadIBANLengths(strings.NewReader(ibanLengthsData))
+		},
+	)
+	return defaultIBANLengths
+}
+
+// NormalizeIBAN returns the electronic format of an IBAN, without spaces and in uppercase
+//
+// Parameters:
+//
+//   - iban: the IBAN to normalize
+//
+// Returns:
+//
+//   - string: the normalized IBAN
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+}
+
+// ValidateIBAN validates an IBAN with its country length and its mod 97 check digits, as defined by ISO 13616. The
+// IBAN may be in the print format, with spaces every four characters
+//
+// Parameters:
+//
+//   - iban: the IBAN to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the IBAN is valid
+func ValidateIBAN(iban string) []error {
+	iban = NormalizeIBAN(iban)
+
+	// Check the format of the IBAN
+	if len(iban) < 5 || !isUpperAlpha(iban[:2]) || !isDigits(iban[2:4]) || !isUpperAlphanumeric(iban[4:]) {
+		return []error{ErrInvalidIBANFormat}
+	}
+
+	// Check the length of the IBAN for its country
+	length, ok := DefaultIBANLengths()[iban[:2]]
+	if !ok {
+		return []error{ErrUnsupportedIBANCountry}
+	}
+	if len(iban) != length {
+		return []error{ErrInvalidIBANLength}
+	}
+
+	// Check the mod 97 check digits, moving the first four characters to the end and converting the letters to
+	// numbers, where 'A' is 10
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return []error{ErrInvalidIBANChecksum}
+	}
+	return nil
+}
+
+// isUpperAlpha checks if a string only contains ASCII uppercase letters
+//
+// Parameters:
+//
+//   - s: the string to check
+//
+// Returns:
+//
+//   - bool: true if the string only contains uppercase letters, false otherwise
+func isUpperAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigits checks if a string only contains ASCII digits
+//
+// Parameters:
+//
+//   - s: the string to check
+//
+// Returns:
+//
+//   - bool: true if the string only contains digits, false otherwise
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isUpperAlphanumeric checks if a string only contains ASCII uppercase letters and digits
+//
+// Parameters:
+//
+//   - s: the string to check
+//
+// Returns:
+//
+//   - bool: true if the string only contains uppercase letters and digits, false otherwise
+func isUpperAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isUpperAlpha(s[i:i+1]) && !isDigits(s[i:i+1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package finance
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateIBAN(t *testing.T) {
+	for _, tt := range []struct {
+		iban string
+		want error
+	}{
+		{iban: "GB82WEST12345698765432"},
+		{iban: "gb82 west 1234 5698 7654 32"},
+		{iban: "DE89370400440532013000"},
+		{iban: "GB83WEST12345698765432", want: ErrInvalidIBANChecksum},
+		{iban: "GB82WEST1234569876543", want: ErrInvalidIBANLength},
+		{iban: "ZZ82WEST12345698765432", want: ErrUnsupportedIBANCountry},
+		{iban: "GBXXWEST12345698765432", want: ErrInvalidIBANFormat},
+		{iban: "GB82-WEST-1234", want: ErrInvalidIBANFormat},
+		{iban: "", want: ErrInvalidIBANFormat},
+	} {
+		errs := ValidateIBAN(tt.iban)
+		if tt.want == nil {
+			if len(errs) != 0 {
+				t.Errorf("ValidateIBAN(%q) = %v, want nil", tt.iban, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || !errors.Is(errs[0], tt.want) {
+			t.Errorf("ValidateIBAN(%q) = %v, want [%v]", tt.iban, errs, tt.want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package finance
+
+// IsLuhnValid checks if a number passes the Luhn mod 10 check
+//
+// Parameters:
+//
+//   - digits: the number to check, it must only contain ASCII digits
+//
+// Returns:
+//
+//   - bool: true if the number is not empty and its check digit is valid, false otherwise
+func IsLuhnValid(digits string) bool {
+	if digits == "" {
+		return false
+	}
+
+	// Sum the digits from the right, doubling every second one
+	sum := 0
+	isDoubled := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		digit := int(c - '0')
+		if isDoubled {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		isDoubled = !isDoubled
+	}
+	return sum%10 == 0
+}
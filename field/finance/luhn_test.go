@@ -0,0 +1,22 @@
+package finance
+
+import "testing"
+
+func TestIsLuhnValid(t *testing.T) {
+	for _, tt := range []struct {
+		digits string
+		want   bool
+	}{
+		{digits: "4111111111111111", want: true},
+		{digits: "79927398713", want: true},
+		{digits: "0", want: true},
+		{digits: "4111111111111112"},
+		{digits: "79927398710"},
+		{digits: ""},
+		{digits: "4111 1111 1111 1111"},
+	} {
+		if got := IsLuhnValid(tt.digits); got != tt.want {
+			t.Errorf("IsLuhnValid(%q) = %v, want %v", tt.digits, got, tt.want)
+		}
+	}
+}
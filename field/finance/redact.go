@@ -0,0 +1,151 @@
+package finance
+
+import (
+	"strings"
+)
+
+const (
+	// RedactionMask is the character that replaces the redacted digits
+	RedactionMask = '*'
+
+	// RedactionVisibleDigits is the number of trailing digits kept visible by the redaction
+	RedactionVisibleDigits = 4
+)
+
+// RedactPAN masks every digit of a card number except the last four, keeping its separators
+//
+// Parameters:
+//
+//   - number: the card number to redact
+//
+// Returns:
+//
+//   - string: the redacted card number, e.g. '**** **** **** 1111'
+func RedactPAN(number string) string {
+	// Count the digits of the card number
+	digitsCount := 0
+	for i := 0; i < len(number); i++ {
+		if number[i] >= '0' && number[i] <= '9' {
+			digitsCount++
+		}
+	}
+
+	// Mask the digits, except the last ones
+	redacted := []byte(number)
+	maskedCount := digitsCount - RedactionVisibleDigits
+	for i := 0; i < len(redacted) && maskedCount > 0; i++ {
+		if redacted[i] >= '0' && redacted[i] <= '9' {
+			redacted[i] = RedactionMask
+			maskedCount--
+		}
+	}
+	return string(redacted)
+}
+
+// FindPANs finds the card numbers in a text, which are the Luhn-valid sequences of 12 to 19 digits, optionally
+// separated by single spaces or hyphens. A sequence that is too long or not Luhn-valid, e.g. a card number followed by
+// its expiry date, is searched for the card numbers made of its consecutive digit groups
+//
+// Parameters:
+//
+//   - text: the text to search
+//
+// Returns:
+//
+//   - [][]int: the start and end byte offsets of each card number, nil if there are none
+func FindPANs(text string) [][]int {
+	var matches [][]int
+	for i := 0; i < len(text); {
+		if !isDigits(text[i : i+1]) {
+			i++
+			continue
+		}
+
+		// Get the digit groups of the sequence of digits and single separators
+		var groups [][]int
+		end := i
+		for end < len(text) {
+			groupStart := end
+			for end < len(text) && isDigits(text[end:end+1]) {
+				end++
+			}
+			groups = append(groups, []int{groupStart, end})
+
+			// Check if the group is followed by a single separator and another group
+			if end+1 < len(text) && (text[end] == ' ' || text[end] == '-') && isDigits(text[end+1:end+2]) {
+				end++
+			} else {
+				break
+			}
+		}
+		matches = append(matches, findGroupsPANs(text, groups)...)
+		i = end
+	}
+	return matches
+}
+
+// findGroupsPANs finds the card numbers made of consecutive digit groups, preferring the longest one starting at
+// each group
+//
+// Parameters:
+//
+//   - text: the text
+//   - groups: the start and end byte offsets of the digit groups of a sequence
+//
+// Returns:
+//
+//   - [][]int: the start and end byte offsets of each card number, nil if there are none
+func findGroupsPANs(text string, groups [][]int) [][]int {
+	var matches [][]int
+	for first := 0; first < len(groups); {
+		// Get the digits of the candidates starting at the group, up to the maximum length
+		var candidates []string
+		digits := make([]byte, 0, MaximumCardLength)
+		for last := first; last < len(groups); last++ {
+			digits = append(digits, text[groups[last][0]:groups[last][1]]...)
+			if len(digits) > MaximumCardLength {
+				break
+			}
+			candidates = append(candidates, string(digits))
+		}
+
+		// Check the candidates from the longest one
+		next := first + 1
+		for last := len(candidates) - 1; last >= 0; last-- {
+			candidate := candidates[last]
+			if len(candidate) >= MinimumCardLength && IsLuhnValid(candidate) {
+				matches = append(matches, []int{groups[first][0], groups[first+last][1]})
+				next = first + last + 1
+				break
+			}
+		}
+		first = next
+	}
+	return matches
+}
+
+// RedactPANs masks the card numbers found in a text, as found by FindPANs
+//
+// Parameters:
+//
+//   - text: the text to redact
+//
+// Returns:
+//
+//   - string: the text with its card numbers redacted
+func RedactPANs(text string) string {
+	matches := FindPANs(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	var builder strings.Builder
+	previousEnd := 0
+	for _, match := range matches {
+		builder.WriteString(text[previousEnd:match[0]])
+		builder.WriteString(RedactPAN(text[match[0]:match[1]]))
+		previousEnd = match[1]
+	}
+	builder.WriteString(text[previousEnd:])
+	return builder.String()
+}
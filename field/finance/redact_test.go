@@ -0,0 +1,60 @@
+package finance
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRedactPAN(t *testing.T) {
+	for _, tt := range []struct {
+		number string
+		want   string
+	}{
+		{number: "4111111111111111", want: "************1111"},
+		{number: "4111 1111 1111 1111", want: "**** **** **** 1111"},
+		{number: "3782-822463-10005", want: "****-******-*0005"},
+		{number: "123", want: "123"},
+	} {
+		if got := RedactPAN(tt.number); got != tt.want {
+			t.Errorf("RedactPAN(%q) = %q, want %q", tt.number, got, tt.want)
+		}
+	}
+}
+
+func TestFindPANs(t *testing.T) {
+	for _, tt := range []struct {
+		text string
+		want [][]int
+	}{
+		{text: "no card here"},
+		{text: "4111111111111111", want: [][]int{{0, 16}}},
+		{text: "card 4111-1111-1111-1111.", want: [][]int{{5, 24}}},
+		{text: "my card 4111 1111 1111 1111 12/25", want: [][]int{{8, 27}}},
+		{text: "4111111111111111 2025", want: [][]int{{0, 16}}},
+		{text: "2025 4111111111111111", want: [][]int{{5, 21}}},
+		{text: "4111111111111111 5555555555554444", want: [][]int{{0, 16}, {17, 33}}},
+		{text: "4111111111111112 2025"},
+		{text: "411111111111111112345"},
+	} {
+		if got := FindPANs(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FindPANs(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestRedactPANs(t *testing.T) {
+	for _, tt := range []struct {
+		text string
+		want string
+	}{
+		{text: "no card here", want: "no card here"},
+		{text: "my card 4111 1111 1111 1111 12/25", want: "my card **** **** **** 1111 12/25"},
+		{text: "4111111111111111 2025", want: "************1111 2025"},
+		{text: "4111111111111111 cvv 123", want: "************1111 cvv 123"},
+		{text: "order 12345 shipped", want: "order 12345 shipped"},
+	} {
+		if got := RedactPANs(tt.text); got != tt.want {
+			t.Errorf("RedactPANs(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
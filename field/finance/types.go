@@ -0,0 +1,47 @@
+package finance
+
+type (
+	// CardBrand is the brand of a payment card
+	CardBrand string
+
+	// CardOptions is the card number validation options struct
+	CardOptions struct {
+		// AllowedBrands are the allowed card brands, if empty the numbers of unknown brands are also allowed
+		AllowedBrands []CardBrand
+
+		// Brands is the card brands table, if nil the bundled table is used
+		Brands *CardBrands
+	}
+
+	// Currency is an ISO 4217 currency
+	Currency struct {
+		// Code is the alphabetic code of the currency, e.g. 'USD'
+		Code string
+
+		// Numeric is the numeric code of the currency, e.g. '840'
+		Numeric string
+
+		// MinorUnits is the number of digits after the decimal separator
+		MinorUnits int
+	}
+)
+
+const (
+	CardBrandVisa       CardBrand = "visa"
+	CardBrandMastercard CardBrand = "mastercard"
+	CardBrandAmex       CardBrand = "amex"
+	CardBrandDiscover   CardBrand = "discover"
+	CardBrandDinersClub CardBrand = "diners_club"
+	CardBrandJCB        CardBrand = "jcb"
+	CardBrandUnionPay   CardBrand = "unionpay"
+	CardBrandMaestro    CardBrand = "maestro"
+	CardBrandMir        CardBrand = "mir"
+)
+
+const (
+	// MinimumCardLength is the minimum length of a card number, as defined by ISO/IEC 7812
+	MinimumCardLength = 12
+
+	// MaximumCardLength is the maximum length of a card number
+	MaximumCardLength = 19
+)
@@ -0,0 +1,228 @@
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+type (
+	// cronSegment is the definition of a segment of a cron expression
+	cronSegment struct {
+		name           string
+		minimum        int
+		maximum        int
+		names          []string
+		allowsQuestion bool
+	}
+)
+
+var (
+	// cronSecondSegment is the optional leading seconds segment
+	cronSecondSegment = cronSegment{name: "second", minimum: 0, maximum: 59}
+
+	// cronSegments are the segments of a standard cron expression, where the day of week 7 is Sunday
+	cronSegments = []cronSegment{
+		{name: "minute", minimum: 0, maximum: 59},
+		{name: "hour", minimum: 0, maximum: 23},
+		{name: "day of month", minimum: 1, maximum: 31, allowsQuestion: true},
+		{
+			name:    "month",
+			minimum: 1,
+			maximum: 12,
+			names:   []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"},
+		},
+		{
+			name:           "day of week",
+			minimum:        0,
+			maximum:        7,
+			names:          []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+			allowsQuestion: true,
+		},
+	}
+
+	// CronDescriptors are the predefined schedules and their equivalent expressions
+	CronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// ValidateCron validates a cron expression, reporting each invalid segment by name, e.g. 'cron hour segment value 24
+// is out of the 0-23 range'. Each segment is a comma-separated list of values, names, ranges and '*', each with an
+// optional step, e.g. '*/15', '1-5' or 'MON,WED'
+//
+// Parameters:
+//
+//   - expression: the cron expression to validate
+//   - options: the cron expression options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the expression is valid
+func ValidateCron(expression string, options *CronOptions) []error {
+	if options == nil {
+		options = &CronOptions{}
+	}
+	expression = strings.TrimSpace(expression)
+
+	// Check the predefined schedules
+	if strings.HasPrefix(expression, "@") {
+		_, ok := CronDescriptors[strings.ToLower(expression)]
+		if ok && options.AllowDescriptors {
+			return nil
+		}
+		if ok {
+			return []error{ErrCronDescriptorsNotAllowed}
+		}
+		return []error{
+			ErrUnknownCronDescriptor.WithMessage(fmt.Sprintf(ErrUnknownCronDescriptorMessage, expression)),
+		}
+	}
+
+	// Get the segments of the expression
+	segments := cronSegments
+	if options.WithSeconds {
+		segments = append([]cronSegment{cronSecondSegment}, cronSegments...)
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != len(segments) {
+		return []error{
+			ErrCronFieldCount.
+				WithMessage(fmt.Sprintf(ErrCronFieldCountMessage, len(segments))).
+				WithMetadata(LimitMetadataKey, strconv.Itoa(len(segments))),
+		}
+	}
+
+	// Check each segment
+	var errs []error
+	for i, field := range fields {
+		errs = append(errs, segments[i].validate(field)...)
+	}
+	return errs
+}
+
+// validate validates a field of a cron expression against the segment definition
+//
+// Parameters:
+//
+//   - field: the field of the cron expression
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the field is valid
+func (c cronSegment) validate(field string) []error {
+	var errs []error
+	for _, item := range strings.Split(field, ",") {
+		if err := c.validateItem(item); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// validateItem validates an item of a comma-separated cron field
+//
+// Parameters:
+//
+//   - item: the item, e.g. '*/15', '1-5', 'MON' or '?'
+//
+// Returns:
+//
+//   - error: the validation error, nil if the item is valid
+func (c cronSegment) validateItem(item string) error {
+	// Check the question mark, which means no specific value
+	if item == "?" && c.allowsQuestion {
+		return nil
+	}
+
+	// Check the step
+	base, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		parsedStep, err := strconv.Atoi(step)
+		if err != nil {
+			return c.newError(ErrInvalidCronSegment, fmt.Sprintf(ErrInvalidCronSegmentMessage, c.name, item))
+		}
+		if parsedStep <= 0 {
+			return c.newError(ErrInvalidCronSegment, fmt.Sprintf(ErrInvalidCronStepMessage, c.name))
+		}
+	}
+	if base == "*" {
+		return nil
+	}
+
+	// Check the value or the range
+	start, end, isRange := strings.Cut(base, "-")
+	startValue, err := c.parseValue(item, start)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	endValue, err := c.parseValue(item, end)
+	if err != nil {
+		return err
+	}
+	if startValue > endValue {
+		return c.newError(
+			ErrInvalidCronSegment,
+			fmt.Sprintf(ErrInvalidCronRangeMessage, c.name, startValue, endValue),
+		)
+	}
+	return nil
+}
+
+// parseValue parses a numeric or named value of a cron segment
+//
+// Parameters:
+//
+//   - item: the item that contains the value, used in the error messages
+//   - value: the value
+//
+// Returns:
+//
+//   - int: the parsed value
+//   - error: the validation error, if the value is not valid or is out of range
+func (c cronSegment) parseValue(item, value string) (int, error) {
+	// Check the names, which are case-insensitive
+	for i, name := range c.names {
+		if strings.EqualFold(value, name) {
+			return c.minimum + i, nil
+		}
+	}
+	if !isNumeric(value) {
+		return 0, c.newError(ErrInvalidCronSegment, fmt.Sprintf(ErrInvalidCronSegmentMessage, c.name, item))
+	}
+
+	// Check the range of the value
+	parsedValue, err := strconv.Atoi(value)
+	if err != nil || parsedValue < c.minimum || parsedValue > c.maximum {
+		return 0, c.newError(
+			ErrCronOutOfRange,
+			fmt.Sprintf(ErrCronOutOfRangeMessage, c.name, value, c.minimum, c.maximum),
+		)
+	}
+	return parsedValue, nil
+}
+
+// newError creates the validation error of the segment
+//
+// Parameters:
+//
+//   - err: the validation error
+//   - message: the message of the error
+//
+// Returns:
+//
+//   - error: the validation error with the segment name in its metadata
+func (c cronSegment) newError(err *govalidatorfield.Error, message string) error {
+	return err.WithMessage(message).WithMetadata(SegmentMetadataKey, c.name)
+}
@@ -0,0 +1,87 @@
+package format
+
+import (
+	"errors"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestValidateCron(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		expression string
+		options    *CronOptions
+		want       []error
+		segments   []string
+	}{
+		{name: "every minute", expression: "* * * * *"},
+		{name: "steps, ranges and lists", expression: "*/15 9-17 1,15 * 1-5"},
+		{name: "names", expression: "0 0 * jan-mar MON,WED"},
+		{name: "question mark", expression: "0 0 ? * 7"},
+		{name: "question mark not allowed", expression: "0 ? * * *", want: []error{ErrInvalidCronSegment}},
+		{name: "with seconds", expression: "30 0 0 * * *", options: &CronOptions{WithSeconds: true}},
+		{
+			name:       "missing seconds",
+			expression: "0 0 * * *",
+			options:    &CronOptions{WithSeconds: true},
+			want:       []error{ErrCronFieldCount},
+		},
+		{name: "too many fields", expression: "0 0 * * * *", want: []error{ErrCronFieldCount}},
+		{
+			name:       "out of range",
+			expression: "0 24 32 * *",
+			want:       []error{ErrCronOutOfRange, ErrCronOutOfRange},
+			segments:   []string{"hour", "day of month"},
+		},
+		{
+			name:       "zero step",
+			expression: "*/0 * * * *",
+			want:       []error{ErrInvalidCronSegment},
+			segments:   []string{"minute"},
+		},
+		{
+			name:       "descending range",
+			expression: "0 0 * * FRI-MON",
+			want:       []error{ErrInvalidCronSegment},
+			segments:   []string{"day of week"},
+		},
+		{
+			name:       "invalid value",
+			expression: "0 0 * FOO *",
+			want:       []error{ErrInvalidCronSegment},
+			segments:   []string{"month"},
+		},
+		{name: "descriptor", expression: "@daily", options: &CronOptions{AllowDescriptors: true}},
+		{name: "uppercase descriptor", expression: "@HOURLY", options: &CronOptions{AllowDescriptors: true}},
+		{name: "descriptors not allowed", expression: "@daily", want: []error{ErrCronDescriptorsNotAllowed}},
+		{
+			name:       "unknown descriptor",
+			expression: "@every",
+			options:    &CronOptions{AllowDescriptors: true},
+			want:       []error{ErrUnknownCronDescriptor},
+		},
+		{name: "unknown descriptor not allowed", expression: "@every", want: []error{ErrUnknownCronDescriptor}},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := ValidateCron(tt.expression, tt.options)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("ValidateCron(%q) = %v, want %v", tt.expression, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("ValidateCron(%q) = %v, want %v", tt.expression, errs, tt.want)
+					}
+					if i >= len(tt.segments) {
+						continue
+					}
+					segment := govalidatorfield.GetMetadata(errs[i])[SegmentMetadataKey]
+					if segment != tt.segments[i] {
+						t.Errorf("ValidateCron(%q) segment = %q, want %q", tt.expression, segment, tt.segments[i])
+					}
+				}
+			},
+		)
+	}
+}
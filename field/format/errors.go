@@ -0,0 +1,80 @@
+package format
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	ErrInvalidSemverMessage         = "semantic version has an invalid %s"
+	ErrSemverLeadingZeroMessage     = "semantic version %s must not have leading zeros"
+	ErrCronFieldCountMessage        = "cron expression must have %d fields"
+	ErrInvalidCronSegmentMessage    = "cron %s segment %q is not valid"
+	ErrCronOutOfRangeMessage        = "cron %s segment value %s is out of the %d-%d range"
+	ErrInvalidCronStepMessage       = "cron %s segment step must be greater than zero"
+	ErrInvalidCronRangeMessage      = "cron %s segment range %d-%d must not be descending"
+	ErrUnknownCronDescriptorMessage = "cron descriptor %s is not supported"
+	ErrInvalidRegexMessage          = "regular expression is not valid: %s"
+	ErrRegexTooLongMessage          = "regular expression must have at most %d characters"
+	ErrRegexTooComplexMessage       = "regular expression must compile to at most %d instructions"
+	ErrInvalidJSONPointerMessage    = "JSON pointer token %d has an invalid escape sequence"
+	ErrMIMETypeNotAllowedMessage    = "MIME type %s is not allowed"
+)
+
+var (
+	ErrInvalidSemver = govalidatorfield.NewError(
+		"format.invalid_semver",
+		"semantic version must be in the MAJOR.MINOR.PATCH format",
+	)
+	ErrCronFieldCount = govalidatorfield.NewError(
+		"format.cron_field_count",
+		"cron expression has an invalid number of fields",
+	)
+	ErrInvalidCronSegment = govalidatorfield.NewError(
+		"format.invalid_cron_segment",
+		"cron segment is not valid",
+	)
+	ErrCronOutOfRange = govalidatorfield.NewError(
+		"format.cron_out_of_range",
+		"cron segment value is out of range",
+	)
+	ErrUnknownCronDescriptor = govalidatorfield.NewError(
+		"format.unknown_cron_descriptor",
+		"cron descriptor is not supported",
+	)
+	ErrCronDescriptorsNotAllowed = govalidatorfield.NewError(
+		"format.cron_descriptors_not_allowed",
+		"cron descriptors are not allowed",
+	)
+	ErrInvalidRegex = govalidatorfield.NewError(
+		"format.invalid_regex",
+		"regular expression is not valid",
+	)
+	ErrRegexTooLong = govalidatorfield.NewError(
+		"format.regex_too_long",
+		"regular expression is too long",
+	)
+	ErrRegexTooComplex = govalidatorfield.NewError(
+		"format.regex_too_complex",
+		"regular expression is too complex",
+	)
+	ErrJSONPointerMissingSlash = govalidatorfield.NewError(
+		"format.json_pointer_missing_slash",
+		"JSON pointer must be empty or start with a slash",
+	)
+	ErrInvalidJSONPointer = govalidatorfield.NewError(
+		"format.invalid_json_pointer",
+		"JSON pointer has an invalid escape sequence",
+	)
+	ErrInvalidMIMEType = govalidatorfield.NewError(
+		"format.invalid_mime_type",
+		"MIME type must be in the type/subtype format",
+	)
+	ErrMIMEParametersNotAllowed = govalidatorfield.NewError(
+		"format.mime_parameters_not_allowed",
+		"MIME type must not have parameters",
+	)
+	ErrMIMETypeNotAllowed = govalidatorfield.NewError(
+		"format.mime_type_not_allowed",
+		"MIME type is not allowed",
+	)
+)
@@ -0,0 +1,64 @@
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// jsonPointerUnescaper unescapes the reference tokens of a JSON pointer, in the order defined by RFC 6901
+	jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// ParseJSONPointer parses a JSON pointer, as defined by RFC 6901, e.g. '/foo/0/a~1b'
+//
+// Parameters:
+//
+//   - pointer: the JSON pointer, where the empty string references the whole document
+//
+// Returns:
+//
+//   - []string: the unescaped reference tokens
+//   - error: the validation error, if the pointer is not valid
+func ParseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, ErrJSONPointerMissingSlash
+	}
+
+	// Check the escape sequences of each reference token, where '~' must be followed by '0' or '1'
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] != '~' {
+				continue
+			}
+			if j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1') {
+				return nil, ErrInvalidJSONPointer.
+					WithMessage(fmt.Sprintf(ErrInvalidJSONPointerMessage, i)).
+					WithMetadata(TokenMetadataKey, strconv.Itoa(i))
+			}
+		}
+		tokens[i] = jsonPointerUnescaper.Replace(token)
+	}
+	return tokens, nil
+}
+
+// ValidateJSONPointer validates a JSON pointer
+//
+// Parameters:
+//
+//   - pointer: the JSON pointer to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the pointer is valid
+func ValidateJSONPointer(pointer string) []error {
+	if _, err := ParseJSONPointer(pointer); err != nil {
+		return []error{err}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package format
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestParseJSONPointer(t *testing.T) {
+	for _, tt := range []struct {
+		pointer string
+		want    []string
+		err     error
+		token   string
+	}{
+		{pointer: ""},
+		{pointer: "/", want: []string{""}},
+		{pointer: "/foo/0", want: []string{"foo", "0"}},
+		{pointer: "/a~1b/m~0n", want: []string{"a/b", "m~n"}},
+		{pointer: "/~01", want: []string{"~1"}},
+		{pointer: "foo", err: ErrJSONPointerMissingSlash},
+		{pointer: "/foo/~2", err: ErrInvalidJSONPointer, token: "1"},
+		{pointer: "/foo~", err: ErrInvalidJSONPointer, token: "0"},
+	} {
+		got, err := ParseJSONPointer(tt.pointer)
+		if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("ParseJSONPointer(%q) = %v, want %v", tt.pointer, err, tt.err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ParseJSONPointer(%q) = %q, want %q", tt.pointer, got, tt.want)
+		}
+		if token := govalidatorfield.GetMetadata(err)[TokenMetadataKey]; token != tt.token {
+			t.Errorf("ParseJSONPointer(%q) token = %q, want %q", tt.pointer, token, tt.token)
+		}
+	}
+}
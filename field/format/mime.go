@@ -0,0 +1,93 @@
+package format
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// ParseMIMEType parses a MIME type, as defined by RFC 2045 and RFC 6838, e.g. 'text/html; charset=utf-8'
+//
+// Parameters:
+//
+//   - value: the MIME type
+//
+// Returns:
+//
+//   - string: the lowercase media type, e.g. 'text/html'
+//   - map[string]string: the parameters
+//   - error: the validation error, if the MIME type is not valid
+func ParseMIMEType(value string) (string, map[string]string, error) {
+	mediaType, parameters, err := mime.ParseMediaType(value)
+	if err != nil {
+		return "", nil, ErrInvalidMIMEType
+	}
+
+	// Check the media type has a type and a subtype, which are not checked by the standard library
+	topLevelType, subtype, ok := strings.Cut(mediaType, "/")
+	if !ok || topLevelType == "" || subtype == "" || topLevelType == "*" || subtype == "*" {
+		return "", nil, ErrInvalidMIMEType
+	}
+	return mediaType, parameters, nil
+}
+
+// ValidateMIMEType validates a MIME type
+//
+// Parameters:
+//
+//   - value: the MIME type to validate
+//   - options: the MIME type options (optional, if nil the parameters are rejected)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the MIME type is valid
+func ValidateMIMEType(value string, options *MIMEOptions) []error {
+	if options == nil {
+		options = &MIMEOptions{}
+	}
+
+	mediaType, parameters, err := ParseMIMEType(value)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+
+	// Check the parameters
+	if len(parameters) > 0 && !options.AllowParameters {
+		errs = append(errs, ErrMIMEParametersNotAllowed)
+	}
+
+	// Check if the media type is allowed
+	if len(options.AllowedTypes) > 0 && !IsMIMETypeAllowed(mediaType, options.AllowedTypes) {
+		errs = append(errs, ErrMIMETypeNotAllowed.WithMessage(fmt.Sprintf(ErrMIMETypeNotAllowedMessage, mediaType)))
+	}
+	return errs
+}
+
+// IsMIMETypeAllowed checks if a media type matches any of the allowed media types
+//
+// Parameters:
+//
+//   - mediaType: the media type, without parameters
+//   - allowedTypes: the allowed media types, where the subtype can be a wildcard, e.g. 'image/*', which does not
+//     match the ExplicitMIMETypes
+//
+// Returns:
+//
+//   - bool: true if the media type is allowed, false otherwise
+func IsMIMETypeAllowed(mediaType string, allowedTypes []string) bool {
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	topLevelType, _, _ := strings.Cut(mediaType, "/")
+	isExplicit := ExplicitMIMETypes[mediaType]
+	for _, allowedType := range allowedTypes {
+		allowedType = strings.ToLower(strings.TrimSpace(allowedType))
+		if allowedType == mediaType {
+			return true
+		}
+		if !isExplicit && (allowedType == "*/*" || allowedType == topLevelType+"/*") {
+			return true
+		}
+	}
+	return false
+}
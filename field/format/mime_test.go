@@ -0,0 +1,22 @@
+package format
+
+import "testing"
+
+func TestIsMIMETypeAllowed(t *testing.T) {
+	for _, tt := range []struct {
+		mediaType    string
+		allowedTypes []string
+		want         bool
+	}{
+		{mediaType: "image/png", allowedTypes: []string{"image/*"}, want: true},
+		{mediaType: "image/png", allowedTypes: []string{"*/*"}, want: true},
+		{mediaType: "text/plain", allowedTypes: []string{"image/*"}},
+		{mediaType: "image/svg+xml", allowedTypes: []string{"image/*"}},
+		{mediaType: "image/svg+xml", allowedTypes: []string{"*/*"}},
+		{mediaType: "image/svg+xml", allowedTypes: []string{"image/*", "Image/SVG+XML"}, want: true},
+	} {
+		if got := IsMIMETypeAllowed(tt.mediaType, tt.allowedTypes); got != tt.want {
+			t.Errorf("IsMIMETypeAllowed(%q, %q) = %v, want %v", tt.mediaType, tt.allowedTypes, got, tt.want)
+		}
+	}
+}
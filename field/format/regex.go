@@ -0,0 +1,97 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+)
+
+// CompileRegex compiles a user-supplied RE2 regular expression, rejecting the patterns whose length or compiled
+// program size exceed the limits. RE2 matching is linear in the input length, so bounding the program size bounds the
+// matching cost per input byte
+//
+// Parameters:
+//
+//   - pattern: the regular expression
+//   - options: the regular expression options (optional, can be nil)
+//
+// Returns:
+//
+//   - *regexp.Regexp: the compiled regular expression
+//   - error: the validation error, if the pattern is not valid or exceeds the limits
+func CompileRegex(pattern string, options *RegexOptions) (*regexp.Regexp, error) {
+	maximumLength, maximumProgramSize := DefaultMaximumRegexLength, DefaultMaximumRegexProgramSize
+	if options != nil && options.MaximumLength > 0 {
+		maximumLength = options.MaximumLength
+	}
+	if options != nil && options.MaximumProgramSize > 0 {
+		maximumProgramSize = options.MaximumProgramSize
+	}
+
+	// Check the length of the pattern before parsing it
+	if len(pattern) > maximumLength {
+		return nil, ErrRegexTooLong.
+			WithMessage(fmt.Sprintf(ErrRegexTooLongMessage, maximumLength)).
+			WithMetadata(LimitMetadataKey, strconv.Itoa(maximumLength))
+	}
+
+	// Parse the pattern, with the same flags used by regexp.Compile
+	parsedPattern, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, newRegexError(err)
+	}
+
+	// Check the size of the compiled program
+	program, err := syntax.Compile(parsedPattern.Simplify())
+	if err != nil {
+		return nil, newRegexError(err)
+	}
+	if len(program.Inst) > maximumProgramSize {
+		return nil, ErrRegexTooComplex.
+			WithMessage(fmt.Sprintf(ErrRegexTooComplexMessage, maximumProgramSize)).
+			WithMetadata(LimitMetadataKey, strconv.Itoa(maximumProgramSize))
+	}
+
+	compiledPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, newRegexError(err)
+	}
+	return compiledPattern, nil
+}
+
+// ValidateRegex validates a user-supplied RE2 regular expression
+//
+// Parameters:
+//
+//   - pattern: the regular expression to validate
+//   - options: the regular expression options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the pattern is valid
+func ValidateRegex(pattern string, options *RegexOptions) []error {
+	if _, err := CompileRegex(pattern, options); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// newRegexError creates the validation error of an invalid regular expression, with the syntax error code in its
+// message, e.g. 'missing closing )'
+//
+// Parameters:
+//
+//   - err: the syntax error
+//
+// Returns:
+//
+//   - error: the validation error
+func newRegexError(err error) error {
+	var syntaxErr *syntax.Error
+	if errors.As(err, &syntaxErr) {
+		return ErrInvalidRegex.WithMessage(fmt.Sprintf(ErrInvalidRegexMessage, syntaxErr.Code.String()))
+	}
+	return ErrInvalidRegex
+}
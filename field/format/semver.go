@@ -0,0 +1,184 @@
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	SemverPartMajor      = "major version"
+	SemverPartMinor      = "minor version"
+	SemverPartPatch      = "patch version"
+	SemverPartPrerelease = "prerelease"
+	SemverPartBuild      = "build metadata"
+)
+
+// ParseSemver parses a semantic version, as defined by SemVer 2.0.0, e.g. '1.2.3-rc.1+build.5'. The 'v' prefix is
+// not part of the specification, so it is rejected
+//
+// Parameters:
+//
+//   - value: the semantic version
+//
+// Returns:
+//
+//   - *Version: the parsed version
+//   - error: the validation error, if the version is not valid
+func ParseSemver(value string) (*Version, error) {
+	version := &Version{}
+
+	// Get the build metadata, whose identifiers can have leading zeros
+	if index := strings.IndexByte(value, '+'); index >= 0 {
+		build := strings.Split(value[index+1:], ".")
+		for _, identifier := range build {
+			if !isSemverIdentifier(identifier) {
+				return nil, newSemverError(ErrInvalidSemverMessage, SemverPartBuild)
+			}
+		}
+		version.Build = build
+		value = value[:index]
+	}
+
+	// Get the prerelease, whose numeric identifiers must not have leading zeros
+	if index := strings.IndexByte(value, '-'); index >= 0 {
+		prerelease := strings.Split(value[index+1:], ".")
+		for _, identifier := range prerelease {
+			if !isSemverIdentifier(identifier) {
+				return nil, newSemverError(ErrInvalidSemverMessage, SemverPartPrerelease)
+			}
+			if isNumeric(identifier) && hasLeadingZero(identifier) {
+				return nil, newSemverError(ErrSemverLeadingZeroMessage, SemverPartPrerelease)
+			}
+		}
+		version.Prerelease = prerelease
+		value = value[:index]
+	}
+
+	// Get the version core
+	core := strings.Split(value, ".")
+	if len(core) != 3 {
+		return nil, ErrInvalidSemver
+	}
+	parts := [3]string{SemverPartMajor, SemverPartMinor, SemverPartPatch}
+	numbers := [3]*uint64{&version.Major, &version.Minor, &version.Patch}
+	for i, number := range core {
+		if !isNumeric(number) {
+			return nil, newSemverError(ErrInvalidSemverMessage, parts[i])
+		}
+		if hasLeadingZero(number) {
+			return nil, newSemverError(ErrSemverLeadingZeroMessage, parts[i])
+		}
+		parsedNumber, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			return nil, newSemverError(ErrInvalidSemverMessage, parts[i])
+		}
+		*numbers[i] = parsedNumber
+	}
+	return version, nil
+}
+
+// ValidateSemver validates a semantic version
+//
+// Parameters:
+//
+//   - value: the semantic version to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the version is valid
+func ValidateSemver(value string) []error {
+	if _, err := ParseSemver(value); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// String returns the canonical representation of the version
+//
+// Returns:
+//
+//   - string: the semantic version
+func (v *Version) String() string {
+	if v == nil {
+		return ""
+	}
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) > 0 {
+		s += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if len(v.Build) > 0 {
+		s += "+" + strings.Join(v.Build, ".")
+	}
+	return s
+}
+
+// newSemverError creates the validation error of an invalid part of a semantic version
+//
+// Parameters:
+//
+//   - message: the format of the error message
+//   - part: the invalid part
+//
+// Returns:
+//
+//   - error: the validation error
+func newSemverError(message, part string) error {
+	return ErrInvalidSemver.WithMessage(fmt.Sprintf(message, part)).WithMetadata(PartMetadataKey, part)
+}
+
+// isSemverIdentifier checks if a string is a non-empty semantic version identifier of ASCII alphanumerics and hyphens
+//
+// Parameters:
+//
+//   - identifier: the identifier
+//
+// Returns:
+//
+//   - bool: true if the identifier is valid, false otherwise
+func isSemverIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+	for i := 0; i < len(identifier); i++ {
+		c := identifier[i]
+		if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumeric checks if a string is a non-empty sequence of ASCII digits
+//
+// Parameters:
+//
+//   - s: the string
+//
+// Returns:
+//
+//   - bool: true if the string is numeric, false otherwise
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// hasLeadingZero checks if a numeric string has a leading zero
+//
+// Parameters:
+//
+//   - s: the numeric string
+//
+// Returns:
+//
+//   - bool: true if the string has more than one digit and starts with zero, false otherwise
+func hasLeadingZero(s string) bool {
+	return len(s) > 1 && s[0] == '0'
+}
@@ -0,0 +1,58 @@
+package format
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestParseSemver(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		want  *Version
+		part  string
+	}{
+		{value: "1.2.3", want: &Version{Major: 1, Minor: 2, Patch: 3}},
+		{value: "0.0.0", want: &Version{}},
+		{
+			value: "1.0.0-alpha.1+build.001",
+			want:  &Version{Major: 1, Prerelease: []string{"alpha", "1"}, Build: []string{"build", "001"}},
+		},
+		{value: "1.0.0-0a.x-y", want: &Version{Major: 1, Prerelease: []string{"0a", "x-y"}}},
+		{value: "1.0.0+exp.sha.5114f85", want: &Version{Major: 1, Build: []string{"exp", "sha", "5114f85"}}},
+		{value: "1.2"},
+		{value: "1.2.3.4"},
+		{value: "v1.2.3", part: SemverPartMajor},
+		{value: "01.2.3", part: SemverPartMajor},
+		{value: "1.02.3", part: SemverPartMinor},
+		{value: "1.2.x", part: SemverPartPatch},
+		{value: "1.2.3-01", part: SemverPartPrerelease},
+		{value: "1.2.3-alpha..1", part: SemverPartPrerelease},
+		{value: "1.2.3+", part: SemverPartBuild},
+		{value: "1.2.3+build_1", part: SemverPartBuild},
+		{value: "18446744073709551616.0.0", part: SemverPartMajor},
+	} {
+		got, err := ParseSemver(tt.value)
+		if tt.want == nil {
+			if !errors.Is(err, ErrInvalidSemver) {
+				t.Errorf("ParseSemver(%q) = %v, want %v", tt.value, err, ErrInvalidSemver)
+			}
+			if part := govalidatorfield.GetMetadata(err)[PartMetadataKey]; part != tt.part {
+				t.Errorf("ParseSemver(%q) part = %q, want %q", tt.value, part, tt.part)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseSemver(%q) = %v", tt.value, err)
+		}
+		if got.Major != tt.want.Major || got.Minor != tt.want.Minor || got.Patch != tt.want.Patch ||
+			!slices.Equal(got.Prerelease, tt.want.Prerelease) || !slices.Equal(got.Build, tt.want.Build) {
+			t.Errorf("ParseSemver(%q) = %+v, want %+v", tt.value, got, tt.want)
+		}
+		if s := got.String(); s != tt.value {
+			t.Errorf("ParseSemver(%q).String() = %q", tt.value, s)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package format
+
+type (
+	// Version is a parsed semantic version
+	Version struct {
+		Major      uint64
+		Minor      uint64
+		Patch      uint64
+		Prerelease []string
+		Build      []string
+	}
+
+	// CronOptions is the cron expression options struct
+	CronOptions struct {
+		// WithSeconds determines if the expression has a leading seconds field, as in Quartz and robfig/cron
+		WithSeconds bool
+
+		// AllowDescriptors determines if the predefined schedules are allowed, e.g. '@daily'
+		AllowDescriptors bool
+	}
+
+	// RegexOptions is the regular expression options struct
+	RegexOptions struct {
+		// MaximumLength is the maximum length of the pattern in bytes, if zero DefaultMaximumRegexLength is used
+		MaximumLength int
+
+		// MaximumProgramSize is the maximum number of instructions of the compiled pattern, which bounds the matching
+		// cost per input byte, if zero DefaultMaximumRegexProgramSize is used
+		MaximumProgramSize int
+	}
+
+	// MIMEOptions is the MIME type options struct
+	MIMEOptions struct {
+		// AllowParameters determines if the parameters are allowed, e.g. '; charset=utf-8'
+		AllowParameters bool
+
+		// AllowedTypes are the allowed media types, where the subtype can be a wildcard, e.g. 'image/*' (optional,
+		// if empty any media type is allowed). The wildcards do not match the ExplicitMIMETypes
+		AllowedTypes []string
+	}
+)
+
+const (
+	// DefaultMaximumRegexLength is the default maximum length of the regular expressions
+	DefaultMaximumRegexLength = 1024
+
+	// DefaultMaximumRegexProgramSize is the default maximum number of instructions of the compiled regular expressions
+	DefaultMaximumRegexProgramSize = 2048
+
+	// PartMetadataKey is the metadata key of the invalid part of the value in the validation errors
+	PartMetadataKey = "part"
+
+	// SegmentMetadataKey is the metadata key of the invalid cron segment in the validation errors
+	SegmentMetadataKey = "segment"
+
+	// TokenMetadataKey is the metadata key of the index of the invalid JSON pointer token in the validation errors
+	TokenMetadataKey = "token"
+
+	// LimitMetadataKey is the metadata key of the violated limit in the validation errors
+	LimitMetadataKey = "limit"
+)
+
+var (
+	// ExplicitMIMETypes are the media types that can carry active content, like the scripts of the SVG images, so
+	// they are only allowed when listed explicitly and never matched by a wildcard, e.g. 'image/*'
+	ExplicitMIMETypes = map[string]bool{
+		"image/svg+xml": true,
+	}
+)
@@ -0,0 +1,46 @@
+package geo
+
+import (
+	"errors"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+var (
+	ErrInvalidGeoJSON = errors.New("invalid GeoJSON polygons")
+)
+
+const (
+	ErrTooPreciseMessage = "%s must have at most %d decimal places"
+)
+
+var (
+	ErrInvalidLatitude = govalidatorfield.NewError(
+		"geo.invalid_latitude",
+		"latitude must be a number between -90 and 90",
+	)
+	ErrInvalidLongitude = govalidatorfield.NewError(
+		"geo.invalid_longitude",
+		"longitude must be a number between -180 and 180",
+	)
+	ErrTooPrecise = govalidatorfield.NewError(
+		"geo.too_precise",
+		"coordinate has too many decimal places",
+	)
+	ErrInvalidPoint = govalidatorfield.NewError(
+		"geo.invalid_point",
+		"point must be a GeoJSON Point with a longitude and a latitude",
+	)
+	ErrInvalidBoundingBox = govalidatorfield.NewError(
+		"geo.invalid_bounding_box",
+		"bounding box must be [west, south, east, north] with the south not greater than the north",
+	)
+	ErrOutsideBoundingBox = govalidatorfield.NewError(
+		"geo.outside_bounding_box",
+		"point is outside the allowed area",
+	)
+	ErrOutsidePolygon = govalidatorfield.NewError(
+		"geo.outside_polygon",
+		"point is outside the allowed polygons",
+	)
+)
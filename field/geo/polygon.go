@@ -0,0 +1,206 @@
+package geo
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type (
+	// Ring is a closed linear ring, whose first and last points are equal
+	Ring []Point
+
+	// Polygon is a polygon, whose first ring is the exterior and the others are the holes
+	Polygon []Ring
+
+	// Polygons is a set of polygons, a point is contained if any of them contains it
+	Polygons []Polygon
+
+	// geoJSONObject is a GeoJSON geometry, feature or feature collection
+	geoJSONObject struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates,omitempty"`
+		Geometry    *geoJSONObject  `json:"geometry,omitempty"`
+		Geometries  []geoJSONObject `json:"geometries,omitempty"`
+		Features    []geoJSONObject `json:"features,omitempty"`
+	}
+)
+
+// LoadPolygons loads the polygons of a GeoJSON document, which can be a Polygon or a MultiPolygon, or a Feature,
+// FeatureCollection or GeometryCollection of them. The other geometries are ignored
+//
+// Parameters:
+//
+//   - reader: the reader of the GeoJSON document
+//
+// Returns:
+//
+//   - Polygons: the polygons
+//   - error: if the document could not be read or decoded, or a polygon is not valid
+func LoadPolygons(reader io.Reader) (Polygons, error) {
+	var object geoJSONObject
+	if err := json.NewDecoder(reader).Decode(&object); err != nil {
+		return nil, err
+	}
+
+	var polygons Polygons
+	if err := object.appendPolygons(&polygons); err != nil {
+		return nil, err
+	}
+	return polygons, nil
+}
+
+// appendPolygons appends the polygons of the GeoJSON object
+//
+// Parameters:
+//
+//   - polygons: the polygons to append to
+//
+// Returns:
+//
+//   - error: if a polygon is not valid
+func (g *geoJSONObject) appendPolygons(polygons *Polygons) error {
+	switch g.Type {
+	case "Polygon":
+		var coordinates [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return ErrInvalidGeoJSON
+		}
+		polygon, err := newPolygon(coordinates)
+		if err != nil {
+			return err
+		}
+		*polygons = append(*polygons, polygon)
+	case "MultiPolygon":
+		var coordinates [][][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return ErrInvalidGeoJSON
+		}
+		for _, polygonCoordinates := range coordinates {
+			polygon, err := newPolygon(polygonCoordinates)
+			if err != nil {
+				return err
+			}
+			*polygons = append(*polygons, polygon)
+		}
+	case "Feature":
+		if g.Geometry != nil {
+			return g.Geometry.appendPolygons(polygons)
+		}
+	case "FeatureCollection":
+		for i := range g.Features {
+			if err := g.Features[i].appendPolygons(polygons); err != nil {
+				return err
+			}
+		}
+	case "GeometryCollection":
+		for i := range g.Geometries {
+			if err := g.Geometries[i].appendPolygons(polygons); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// newPolygon creates a polygon from its GeoJSON coordinates, where each position is [longitude, latitude]
+//
+// Parameters:
+//
+//   - coordinates: the rings of the polygon
+//
+// Returns:
+//
+//   - Polygon: the polygon
+//   - error: if a ring has less than four positions, is not closed or has invalid coordinates
+func newPolygon(coordinates [][][]float64) (Polygon, error) {
+	if len(coordinates) == 0 {
+		return nil, ErrInvalidGeoJSON
+	}
+
+	polygon := make(Polygon, 0, len(coordinates))
+	for _, ringCoordinates := range coordinates {
+		if len(ringCoordinates) < 4 {
+			return nil, ErrInvalidGeoJSON
+		}
+		ring := make(Ring, 0, len(ringCoordinates))
+		for _, position := range ringCoordinates {
+			if len(position) < 2 {
+				return nil, ErrInvalidGeoJSON
+			}
+			point := Point{Latitude: position[1], Longitude: position[0]}
+			if len(ValidateLatitude(point.Latitude)) > 0 || len(ValidateLongitude(point.Longitude)) > 0 {
+				return nil, ErrInvalidGeoJSON
+			}
+			ring = append(ring, point)
+		}
+		if ring[0] != ring[len(ring)-1] {
+			return nil, ErrInvalidGeoJSON
+		}
+		polygon = append(polygon, ring)
+	}
+	return polygon, nil
+}
+
+// Contains checks if the ring contains a point, using the even-odd rule on the planar longitude and latitude
+//
+// Parameters:
+//
+//   - point: the point
+//
+// Returns:
+//
+//   - bool: true if the point is inside the ring, false otherwise
+func (r Ring) Contains(point Point) bool {
+	isInside := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a, b := r[i], r[j]
+		if (a.Latitude > point.Latitude) != (b.Latitude > point.Latitude) {
+			// Get the longitude where the edge crosses the latitude of the point
+			crossing := a.Longitude + (point.Latitude-a.Latitude)*(b.Longitude-a.Longitude)/(b.Latitude-a.Latitude)
+			if point.Longitude < crossing {
+				isInside = !isInside
+			}
+		}
+	}
+	return isInside
+}
+
+// Contains checks if the polygon contains a point, which must be inside its exterior ring and outside its holes.
+// The edges are straight lines in longitude and latitude, so the polygons must not cross the antimeridian
+//
+// Parameters:
+//
+//   - point: the point
+//
+// Returns:
+//
+//   - bool: true if the point is inside the polygon, false otherwise
+func (p Polygon) Contains(point Point) bool {
+	if len(p) == 0 || !p[0].Contains(point) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if hole.Contains(point) {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains checks if any of the polygons contains a point
+//
+// Parameters:
+//
+//   - point: the point
+//
+// Returns:
+//
+//   - bool: true if the point is inside any polygon, false otherwise
+func (p Polygons) Contains(point Point) bool {
+	for _, polygon := range p {
+		if polygon.Contains(point) {
+			return true
+		}
+	}
+	return false
+}
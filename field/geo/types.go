@@ -0,0 +1,52 @@
+package geo
+
+type (
+	// Point is a geographic point in WGS 84 decimal degrees
+	Point struct {
+		Latitude  float64
+		Longitude float64
+	}
+
+	// BoundingBox is a geographic bounding box, as in GeoJSON, which crosses the antimeridian when the west is greater
+	// than the east
+	BoundingBox struct {
+		West  float64
+		South float64
+		East  float64
+		North float64
+	}
+
+	// FieldNames are the names of the fields of a {lat,lng} struct, the empty names are replaced by 'Latitude' and
+	// 'Longitude'
+	FieldNames struct {
+		Latitude  string
+		Longitude string
+	}
+
+	// Options is the geographic point options struct
+	Options struct {
+		// MaximumPrecision is the maximum number of decimal places of the coordinates, e.g. 6 is about 0.1 m (optional,
+		// if zero it is not checked)
+		MaximumPrecision int
+
+		// BoundingBox is the area that must contain the point (optional, can be nil)
+		BoundingBox *BoundingBox
+
+		// Polygons are the areas that must contain the point, e.g. loaded with LoadPolygons (optional, can be nil)
+		Polygons Polygons
+
+		// FieldNames are the names of the fields of the struct validated by the struct rule
+		FieldNames FieldNames
+	}
+)
+
+const (
+	// CoordinateMetadataKey is the metadata key of the invalid coordinate in the validation errors
+	CoordinateMetadataKey = "coordinate"
+
+	// LimitMetadataKey is the metadata key of the violated limit in the validation errors
+	LimitMetadataKey = "limit"
+
+	CoordinateLatitude  = "latitude"
+	CoordinateLongitude = "longitude"
+)
@@ -0,0 +1,272 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+// ValidateLatitude validates a latitude in decimal degrees
+//
+// Parameters:
+//
+//   - latitude: the latitude to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the latitude is valid
+func ValidateLatitude(latitude float64) []error {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return []error{ErrInvalidLatitude}
+	}
+	return nil
+}
+
+// ValidateLongitude validates a longitude in decimal degrees
+//
+// Parameters:
+//
+//   - longitude: the longitude to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the longitude is valid
+func ValidateLongitude(longitude float64) []error {
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return []error{ErrInvalidLongitude}
+	}
+	return nil
+}
+
+// ValidatePoint validates a geographic point against the ranges, the precision limit and the allowed areas
+//
+// Parameters:
+//
+//   - point: the point to validate
+//   - options: the geographic point options (optional, if nil only the ranges are checked)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the point is valid
+func ValidatePoint(point Point, options *Options) []error {
+	latitudeErrs, longitudeErrs, pointErrs := ValidateCoordinates(point, options)
+	return append(append(latitudeErrs, longitudeErrs...), pointErrs...)
+}
+
+// ParsePoint parses a GeoJSON Point geometry or a Feature with a Point geometry, e.g.
+// '{"type": "Point", "coordinates": [-66.9036, 10.4806]}'
+//
+// Parameters:
+//
+//   - data: the GeoJSON document
+//
+// Returns:
+//
+//   - Point: the parsed point
+//   - error: the validation error, if the document is not a valid point
+func ParsePoint(data []byte) (Point, error) {
+	var object geoJSONObject
+	if err := json.Unmarshal(data, &object); err != nil {
+		return Point{}, ErrInvalidPoint
+	}
+	if object.Type == "Feature" && object.Geometry != nil {
+		object = *object.Geometry
+	}
+	if object.Type != "Point" {
+		return Point{}, ErrInvalidPoint
+	}
+
+	// Get the position, which is [longitude, latitude] with an optional altitude
+	var position []float64
+	if err := json.Unmarshal(object.Coordinates, &position); err != nil || len(position) < 2 || len(position) > 3 {
+		return Point{}, ErrInvalidPoint
+	}
+	return Point{Latitude: position[1], Longitude: position[0]}, nil
+}
+
+// ParseBoundingBox parses a GeoJSON bounding box, either [west, south, east, north] or the 3D form
+// [west, south, minimum altitude, east, north, maximum altitude]
+//
+// Parameters:
+//
+//   - values: the bounding box values
+//
+// Returns:
+//
+//   - *BoundingBox: the parsed bounding box
+//   - error: the validation error, if the bounding box is not valid
+func ParseBoundingBox(values []float64) (*BoundingBox, error) {
+	var boundingBox BoundingBox
+	switch len(values) {
+	case 4:
+		boundingBox = BoundingBox{West: values[0], South: values[1], East: values[2], North: values[3]}
+	case 6:
+		boundingBox = BoundingBox{West: values[0], South: values[1], East: values[3], North: values[4]}
+	default:
+		return nil, ErrInvalidBoundingBox
+	}
+
+	// Check the ranges, the west can be greater than the east when the box crosses the antimeridian
+	if len(ValidateLatitude(boundingBox.South)) > 0 || len(ValidateLatitude(boundingBox.North)) > 0 ||
+		len(ValidateLongitude(boundingBox.West)) > 0 || len(ValidateLongitude(boundingBox.East)) > 0 ||
+		boundingBox.South > boundingBox.North {
+		return nil, ErrInvalidBoundingBox
+	}
+	return &boundingBox, nil
+}
+
+// ValidateBoundingBox validates a GeoJSON bounding box
+//
+// Parameters:
+//
+//   - values: the bounding box values to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the bounding box is valid
+func ValidateBoundingBox(values []float64) []error {
+	if _, err := ParseBoundingBox(values); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// Contains checks if the bounding box contains a point, including its edges
+//
+// Parameters:
+//
+//   - point: the point
+//
+// Returns:
+//
+//   - bool: true if the point is inside the bounding box, false otherwise
+func (b *BoundingBox) Contains(point Point) bool {
+	if b == nil {
+		return false
+	}
+	if point.Latitude < b.South || point.Latitude > b.North {
+		return false
+	}
+
+	// Check the longitude, the box crosses the antimeridian when the west is greater than the east
+	if b.West <= b.East {
+		return point.Longitude >= b.West && point.Longitude <= b.East
+	}
+	return point.Longitude >= b.West || point.Longitude <= b.East
+}
+
+// NewStructRule creates a struct-level rule that validates a {lat,lng} struct, which can be added to the nested
+// mapper of the struct, e.g. mapper.GetFieldNestedMapper("Location").AddStructRule(geo.NewStructRule(options)).
+// The errors of the allowed areas are reported on the latitude field
+//
+// Parameters:
+//
+//   - options: the geographic point options (optional, if nil only the ranges are checked)
+//
+// Returns:
+//
+//   - func(structInstance any) map[string][]error: the struct-level rule, whose errors are keyed by the field names
+func NewStructRule(options *Options) func(structInstance any) map[string][]error {
+	fieldNames := FieldNames{}
+	if options != nil {
+		fieldNames = options.FieldNames
+	}
+	fieldNames = govalidatorfield.WithDefaultFieldNames(fieldNames)
+
+	return func(structInstance any) map[string][]error {
+		// Get the coordinates from the struct fields, the missing ones are left to the required fields validation
+		latitude, hasLatitude := govalidatorfield.FloatField(structInstance, fieldNames.Latitude)
+		longitude, hasLongitude := govalidatorfield.FloatField(structInstance, fieldNames.Longitude)
+		if !hasLatitude || !hasLongitude {
+			return nil
+		}
+
+		latitudeErrs, longitudeErrs, pointErrs := ValidateCoordinates(
+			Point{Latitude: latitude, Longitude: longitude},
+			options,
+		)
+		latitudeErrs = append(latitudeErrs, pointErrs...)
+		if len(latitudeErrs) == 0 && len(longitudeErrs) == 0 {
+			return nil
+		}
+		return map[string][]error{
+			fieldNames.Latitude:  latitudeErrs,
+			fieldNames.Longitude: longitudeErrs,
+		}
+	}
+}
+
+// ValidateCoordinates validates a geographic point, returning the errors of each coordinate and of the point
+//
+// Parameters:
+//
+//   - point: the point to validate
+//   - options: the geographic point options (optional, if nil only the ranges are checked)
+//
+// Returns:
+//
+//   - []error: the validation errors of the latitude
+//   - []error: the validation errors of the longitude
+//   - []error: the validation errors of the allowed areas
+func ValidateCoordinates(point Point, options *Options) ([]error, []error, []error) {
+	latitudeErrs := ValidateLatitude(point.Latitude)
+	longitudeErrs := ValidateLongitude(point.Longitude)
+	if options == nil || len(latitudeErrs) > 0 || len(longitudeErrs) > 0 {
+		return latitudeErrs, longitudeErrs, nil
+	}
+
+	// Check the precision of the coordinates
+	if options.MaximumPrecision > 0 {
+		if decimalPlaces(point.Latitude) > options.MaximumPrecision {
+			latitudeErrs = append(latitudeErrs, newTooPreciseError(CoordinateLatitude, options.MaximumPrecision))
+		}
+		if decimalPlaces(point.Longitude) > options.MaximumPrecision {
+			longitudeErrs = append(longitudeErrs, newTooPreciseError(CoordinateLongitude, options.MaximumPrecision))
+		}
+	}
+
+	// Check the allowed areas
+	var pointErrs []error
+	if options.BoundingBox != nil && !options.BoundingBox.Contains(point) {
+		pointErrs = append(pointErrs, ErrOutsideBoundingBox)
+	}
+	if options.Polygons != nil && !options.Polygons.Contains(point) {
+		pointErrs = append(pointErrs, ErrOutsidePolygon)
+	}
+	return latitudeErrs, longitudeErrs, pointErrs
+}
+
+// newTooPreciseError creates the validation error of a coordinate with too many decimal places
+//
+// Parameters:
+//
+//   - coordinate: the name of the coordinate
+//   - maximumPrecision: the maximum number of decimal places
+//
+// Returns:
+//
+//   - error: the validation error
+func newTooPreciseError(coordinate string, maximumPrecision int) error {
+	return ErrTooPrecise.
+		WithMessage(fmt.Sprintf(ErrTooPreciseMessage, coordinate, maximumPrecision)).
+		WithMetadata(CoordinateMetadataKey, coordinate).
+		WithMetadata(LimitMetadataKey, strconv.Itoa(maximumPrecision))
+}
+
+// decimalPlaces returns the number of decimal places of the shortest decimal representation of a float
+//
+// Parameters:
+//
+//   - f: the float
+//
+// Returns:
+//
+//   - int: the number of decimal places
+func decimalPlaces(f float64) int {
+	_, fraction, _ := strings.Cut(strconv.FormatFloat(f, 'f', -1, 64), ".")
+	return len(fraction)
+}
@@ -0,0 +1,52 @@
+package identifier
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	ErrUUIDVersionNotAllowedMessage = "UUID version %d is not allowed"
+)
+
+var (
+	ErrInvalidUUID = govalidatorfield.NewError(
+		"identifier.invalid_uuid",
+		"UUID must be in the canonical 8-4-4-4-12 hexadecimal format",
+	)
+	ErrUUIDVersionNotAllowed = govalidatorfield.NewError(
+		"identifier.uuid_version_not_allowed",
+		"UUID version is not allowed",
+	)
+	ErrUUIDVariantNotAllowed = govalidatorfield.NewError(
+		"identifier.uuid_variant_not_allowed",
+		"UUID variant is not allowed",
+	)
+	ErrNilUUIDNotAllowed = govalidatorfield.NewError(
+		"identifier.nil_uuid_not_allowed",
+		"nil UUID is not allowed",
+	)
+	ErrMaxUUIDNotAllowed = govalidatorfield.NewError(
+		"identifier.max_uuid_not_allowed",
+		"max UUID is not allowed",
+	)
+	ErrInvalidULID = govalidatorfield.NewError(
+		"identifier.invalid_ulid",
+		"ULID must be 26 Crockford base32 characters",
+	)
+	ErrInvalidKSUID = govalidatorfield.NewError(
+		"identifier.invalid_ksuid",
+		"KSUID must be 27 base62 characters",
+	)
+	ErrInvalidSnowflake = govalidatorfield.NewError(
+		"identifier.invalid_snowflake",
+		"snowflake ID must be a positive 63-bit decimal number",
+	)
+	ErrTimestampOutOfWindow = govalidatorfield.NewError(
+		"identifier.timestamp_out_of_window",
+		"identifier timestamp is outside the allowed time window",
+	)
+	ErrUnsupportedType = govalidatorfield.NewError(
+		"identifier.unsupported_type",
+		"identifier type is not supported",
+	)
+)
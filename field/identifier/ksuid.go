@@ -0,0 +1,83 @@
+package identifier
+
+import (
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// base62Alphabet is the base62 alphabet used by the KSUIDs
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	// KSUIDLength is the length of a KSUID
+	KSUIDLength = 27
+
+	// ksuidEpoch is the epoch of the KSUID timestamps, in seconds since the Unix epoch
+	ksuidEpoch = 1400000000
+
+	// ksuidBytesLength is the length of the decoded KSUID, a 4-byte timestamp followed by a 16-byte payload
+	ksuidBytesLength = 20
+)
+
+var (
+	// maximumKSUID is the maximum value of a KSUID, which is 2^160 - 1
+	maximumKSUID = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 8*ksuidBytesLength), big.NewInt(1))
+)
+
+// KSUIDTime returns the timestamp of a KSUID
+//
+// Parameters:
+//
+//   - value: the KSUID, case-sensitive
+//
+// Returns:
+//
+//   - time.Time: the KSUID timestamp
+//   - error: the validation error, if the value is not a KSUID
+func KSUIDTime(value string) (time.Time, error) {
+	if len(value) != KSUIDLength {
+		return time.Time{}, ErrInvalidKSUID
+	}
+
+	// Decode the base62 value, which must fit in 160 bits
+	decoded := new(big.Int)
+	base := big.NewInt(int64(len(base62Alphabet)))
+	for i := 0; i < KSUIDLength; i++ {
+		index := strings.IndexByte(base62Alphabet, value[i])
+		if index < 0 {
+			return time.Time{}, ErrInvalidKSUID
+		}
+		decoded.Mul(decoded, base).Add(decoded, big.NewInt(int64(index)))
+	}
+	if decoded.Cmp(maximumKSUID) > 0 {
+		return time.Time{}, ErrInvalidKSUID
+	}
+
+	// The first 4 bytes are the timestamp in seconds since the KSUID epoch
+	var bytes [ksuidBytesLength]byte
+	decoded.FillBytes(bytes[:])
+	seconds := int64(bytes[0])<<24 | int64(bytes[1])<<16 | int64(bytes[2])<<8 | int64(bytes[3])
+	return time.Unix(seconds+ksuidEpoch, 0).UTC(), nil
+}
+
+// ValidateKSUID validates a KSUID and its timestamp
+//
+// Parameters:
+//
+//   - value: the KSUID to validate, case-sensitive
+//   - window: the time window of the KSUID timestamp (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the KSUID is valid
+func ValidateKSUID(value string, window *TimeWindow) []error {
+	timestamp, err := KSUIDTime(value)
+	if err != nil {
+		return []error{err}
+	}
+	if err = window.Validate(timestamp); err != nil {
+		return []error{err}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package identifier
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKSUIDTime(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		want  time.Time
+		err   error
+	}{
+		{value: "0ujtsYcgvSTl8PAuAdqWYSMnLOv", want: time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC)},
+		{value: "000000000000000000000000000", want: time.Unix(1400000000, 0).UTC()},
+		{value: "aWgEPTl1tmebfsQzFP4bxwgy80V", want: time.Unix(1400000000+1<<32-1, 0).UTC()},
+		{value: "aWgEPTl1tmebfsQzFP4bxwgy80W", err: ErrInvalidKSUID},
+		{value: "0ujtsYcgvSTl8PAuAdqWYSMnLO-", err: ErrInvalidKSUID},
+		{value: "0ujtsYcgvSTl8PAuAdqWYSMnLO", err: ErrInvalidKSUID},
+	} {
+		got, err := KSUIDTime(tt.value)
+		if !errors.Is(err, tt.err) || !got.Equal(tt.want) {
+			t.Errorf("KSUIDTime(%q) = %v, %v, want %v, %v", tt.value, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestValidateKSUID(t *testing.T) {
+	timestamp := time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC)
+	for _, tt := range []struct {
+		name   string
+		value  string
+		window *TimeWindow
+		want   []error
+	}{
+		{name: "valid", value: "0ujtsYcgvSTl8PAuAdqWYSMnLOv"},
+		{name: "invalid", value: "0ujtsYcgvSTl8PAuAdqWYSMnLO", want: []error{ErrInvalidKSUID}},
+		{
+			name:   "inside the time window",
+			value:  "0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+			window: NewTimeWindow(timestamp, time.Second, 0),
+		},
+		{
+			name:   "outside the time window",
+			value:  "0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+			window: NewTimeWindow(timestamp.Add(-time.Second), 0, 0),
+			want:   []error{ErrTimestampOutOfWindow},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := ValidateKSUID(tt.value, tt.window)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("ValidateKSUID(%q) = %v, want %v", tt.value, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("ValidateKSUID(%q) = %v, want %v", tt.value, errs, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,133 @@
+package identifier
+
+import (
+	"reflect"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	RuleUUID      = "uuid"
+	RuleUUIDv4    = "uuid_v4"
+	RuleUUIDv7    = "uuid_v7"
+	RuleULID      = "ulid"
+	RuleKSUID     = "ksuid"
+	RuleSnowflake = "snowflake"
+)
+
+// RegisterRules registers the identifier rules without time windows on a rule registry, so they can be referenced
+// from the struct tags, e.g. identifier.RegisterRules(field.DefaultRules()) followed by a `rules:"uuid_v7"` tag. The
+// registered rules are uuid, uuid_v4, uuid_v7, ulid, ksuid and snowflake
+//
+// Parameters:
+//
+//   - rules: the rule registry
+func RegisterRules(rules *govalidatorfield.Rules) {
+	if rules == nil {
+		return
+	}
+	rules.Register(RuleUUID, NewUUIDRule(nil))
+	rules.Register(RuleUUIDv4, NewUUIDRule(&UUIDOptions{Versions: []int{4}}))
+	rules.Register(RuleUUIDv7, NewUUIDRule(&UUIDOptions{Versions: []int{7}}))
+	rules.Register(RuleULID, NewULIDRule(nil))
+	rules.Register(RuleKSUID, NewKSUIDRule(nil))
+	rules.Register(RuleSnowflake, NewSnowflakeRule(nil))
+}
+
+// NewUUIDRule creates a field rule that validates a string field as a UUID, which can be added to a mapper field,
+// e.g. mapper.AddFieldRule("ID", identifier.NewUUIDRule(options))
+//
+// Parameters:
+//
+//   - options: the UUID validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func NewUUIDRule(options *UUIDOptions) func(fieldValue any) []error {
+	return newRule(
+		func(value string) []error {
+			return ValidateUUID(value, options)
+		},
+	)
+}
+
+// NewULIDRule creates a field rule that validates a string field as a ULID, which can be added to a mapper field. The
+// time window is fixed when the rule is created, so it is usually an absolute window
+//
+// Parameters:
+//
+//   - window: the time window of the ULID timestamp (optional, can be nil)
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func NewULIDRule(window *TimeWindow) func(fieldValue any) []error {
+	return newRule(
+		func(value string) []error {
+			return ValidateULID(value, window)
+		},
+	)
+}
+
+// NewKSUIDRule creates a field rule that validates a string field as a KSUID, which can be added to a mapper field.
+// The time window is fixed when the rule is created, so it is usually an absolute window
+//
+// Parameters:
+//
+//   - window: the time window of the KSUID timestamp (optional, can be nil)
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func NewKSUIDRule(window *TimeWindow) func(fieldValue any) []error {
+	return newRule(
+		func(value string) []error {
+			return ValidateKSUID(value, window)
+		},
+	)
+}
+
+// NewSnowflakeRule creates a field rule that validates a string field as a snowflake ID, which can be added to a
+// mapper field
+//
+// Parameters:
+//
+//   - options: the snowflake ID validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func NewSnowflakeRule(options *SnowflakeOptions) func(fieldValue any) []error {
+	return newRule(
+		func(value string) []error {
+			return ValidateSnowflake(value, options)
+		},
+	)
+}
+
+// newRule creates a field rule that validates the string value of a field
+//
+// Parameters:
+//
+//   - validateFn: the function that validates the string value
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func newRule(validateFn func(value string) []error) func(fieldValue any) []error {
+	return func(fieldValue any) []error {
+		// Get the string value of the field, so named string types and pointers are supported
+		reflectedValue := reflect.ValueOf(fieldValue)
+		for reflectedValue.Kind() == reflect.Ptr {
+			if reflectedValue.IsNil() {
+				return nil
+			}
+			reflectedValue = reflectedValue.Elem()
+		}
+		if reflectedValue.Kind() != reflect.String {
+			return []error{ErrUnsupportedType}
+		}
+		return validateFn(reflectedValue.String())
+	}
+}
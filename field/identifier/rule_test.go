@@ -0,0 +1,45 @@
+package identifier
+
+import (
+	"errors"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestRegisterRules(t *testing.T) {
+	rules := govalidatorfield.NewRules()
+	RegisterRules(rules)
+
+	type ID string
+	id := ID("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+	for _, tt := range []struct {
+		name       string
+		fieldValue any
+		want       []error
+	}{
+		{name: RuleUUID, fieldValue: "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{name: RuleUUIDv4, fieldValue: "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{name: RuleUUIDv4, fieldValue: id, want: []error{ErrUUIDVersionNotAllowed}},
+		{name: RuleUUIDv7, fieldValue: &id},
+		{name: RuleUUIDv7, fieldValue: (*string)(nil)},
+		{name: RuleUUIDv7, fieldValue: 7, want: []error{ErrUnsupportedType}},
+		{name: RuleULID, fieldValue: "01ARZ3NDEKTSV4RRFFQ69G5FAV"},
+		{name: RuleKSUID, fieldValue: "0ujtsYcgvSTl8PAuAdqWYSMnLOv"},
+		{name: RuleSnowflake, fieldValue: "0", want: []error{ErrInvalidSnowflake}},
+	} {
+		rule, ok := rules.Lookup(tt.name)
+		if !ok {
+			t.Fatalf("rules.Lookup(%q) not found", tt.name)
+		}
+		errs := rule(tt.fieldValue)
+		if len(errs) != len(tt.want) {
+			t.Fatalf("%s rule(%v) = %v, want %v", tt.name, tt.fieldValue, errs, tt.want)
+		}
+		for i := range errs {
+			if !errors.Is(errs[i], tt.want[i]) {
+				t.Errorf("%s rule(%v) = %v, want %v", tt.name, tt.fieldValue, errs, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package identifier
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// snowflakeTimestampShift is the number of bits after the timestamp of a snowflake ID, which are the worker and
+	// sequence bits
+	snowflakeTimestampShift = 22
+)
+
+// SnowflakeTime returns the timestamp of a snowflake ID
+//
+// Parameters:
+//
+//   - value: the snowflake ID, as a decimal number
+//   - epoch: the epoch of the snowflake timestamps, if zero TwitterSnowflakeEpoch is used
+//
+// Returns:
+//
+//   - time.Time: the snowflake ID timestamp
+//   - error: the validation error, if the value is not a snowflake ID
+func SnowflakeTime(value string, epoch time.Time) (time.Time, error) {
+	if epoch.IsZero() {
+		epoch = TwitterSnowflakeEpoch
+	}
+
+	// Check that the value is a positive decimal number without signs or leading zeros
+	if value == "" || value[0] < '1' || value[0] > '9' {
+		return time.Time{}, ErrInvalidSnowflake
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, ErrInvalidSnowflake
+	}
+	return epoch.Add(time.Duration(id>>snowflakeTimestampShift) * time.Millisecond).UTC(), nil
+}
+
+// ValidateSnowflake validates a snowflake ID and its timestamp
+//
+// Parameters:
+//
+//   - value: the snowflake ID to validate, as a decimal number
+//   - options: the snowflake ID validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the snowflake ID is valid
+func ValidateSnowflake(value string, options *SnowflakeOptions) []error {
+	if options == nil {
+		options = &SnowflakeOptions{}
+	}
+
+	timestamp, err := SnowflakeTime(value, options.Epoch)
+	if err != nil {
+		return []error{err}
+	}
+	if err = options.Window.Validate(timestamp); err != nil {
+		return []error{err}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package identifier
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSnowflakeTime(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		epoch time.Time
+		want  time.Time
+		err   error
+	}{
+		{
+			value: "175928847299117063",
+			epoch: DiscordSnowflakeEpoch,
+			want:  time.Date(2016, 4, 30, 11, 18, 25, 796000000, time.UTC),
+		},
+		{value: "4194304", want: TwitterSnowflakeEpoch.Add(time.Millisecond)},
+		{value: "0", err: ErrInvalidSnowflake},
+		{value: "0175928847299117063", err: ErrInvalidSnowflake},
+		{value: "-175928847299117063", err: ErrInvalidSnowflake},
+		{value: "+175928847299117063", err: ErrInvalidSnowflake},
+		{value: "9223372036854775808", err: ErrInvalidSnowflake},
+		{value: "17592884729911706x", err: ErrInvalidSnowflake},
+	} {
+		got, err := SnowflakeTime(tt.value, tt.epoch)
+		if !errors.Is(err, tt.err) || !got.Equal(tt.want) {
+			t.Errorf("SnowflakeTime(%q) = %v, %v, want %v, %v", tt.value, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestValidateSnowflake(t *testing.T) {
+	timestamp := time.Date(2016, 4, 30, 11, 18, 25, 796000000, time.UTC)
+	for _, tt := range []struct {
+		name    string
+		value   string
+		options *SnowflakeOptions
+		want    []error
+	}{
+		{name: "valid", value: "175928847299117063"},
+		{name: "invalid", value: "0", want: []error{ErrInvalidSnowflake}},
+		{
+			name:  "inside the time window",
+			value: "175928847299117063",
+			options: &SnowflakeOptions{
+				Epoch:  DiscordSnowflakeEpoch,
+				Window: NewTimeWindow(timestamp, time.Hour, 0),
+			},
+		},
+		{
+			name:  "outside the time window",
+			value: "175928847299117063",
+			options: &SnowflakeOptions{
+				Epoch:  DiscordSnowflakeEpoch,
+				Window: NewTimeWindow(timestamp.Add(time.Hour), time.Minute, 0),
+			},
+			want: []error{ErrTimestampOutOfWindow},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := ValidateSnowflake(tt.value, tt.options)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("ValidateSnowflake(%q) = %v, want %v", tt.value, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("ValidateSnowflake(%q) = %v, want %v", tt.value, errs, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,111 @@
+package identifier
+
+import (
+	"time"
+)
+
+type (
+	// Variant is the variant of a UUID, as defined by RFC 9562
+	Variant int
+
+	// TimeWindow is the time window the timestamps of the time-ordered identifiers must fall inside
+	TimeWindow struct {
+		// NotBefore is the earliest allowed timestamp, if zero it is not checked
+		NotBefore time.Time
+
+		// NotAfter is the latest allowed timestamp, if zero it is not checked
+		NotAfter time.Time
+	}
+
+	// UUIDOptions is the UUID validation options struct
+	UUIDOptions struct {
+		// Versions are the allowed UUID versions, if empty every version is allowed
+		Versions []int
+
+		// Variants are the allowed UUID variants, if empty only VariantRFC9562 is allowed
+		Variants []Variant
+
+		// AllowNil allows the nil UUID, '00000000-0000-0000-0000-000000000000'
+		AllowNil bool
+
+		// AllowMax allows the max UUID, 'ffffffff-ffff-ffff-ffff-ffffffffffff'
+		AllowMax bool
+
+		// Window is the time window of the time-ordered UUIDs, which are the versions 1, 6 and 7 (optional, can be
+		// nil)
+		Window *TimeWindow
+	}
+
+	// SnowflakeOptions is the snowflake ID validation options struct
+	SnowflakeOptions struct {
+		// Epoch is the epoch of the snowflake timestamps, if zero TwitterSnowflakeEpoch is used
+		Epoch time.Time
+
+		// Window is the time window of the snowflake timestamps (optional, can be nil)
+		Window *TimeWindow
+	}
+)
+
+const (
+	// TimestampMetadataKey is the metadata key of the identifier timestamp in the validation errors
+	TimestampMetadataKey = "timestamp"
+)
+
+const (
+	VariantNCS Variant = iota
+	VariantRFC9562
+	VariantMicrosoft
+	VariantFuture
+)
+
+var (
+	// TwitterSnowflakeEpoch is the epoch of the Twitter snowflake IDs
+	TwitterSnowflakeEpoch = time.UnixMilli(1288834974657).UTC()
+
+	// DiscordSnowflakeEpoch is the epoch of the Discord snowflake IDs
+	DiscordSnowflakeEpoch = time.UnixMilli(1420070400000).UTC()
+)
+
+// NewTimeWindow creates a time window relative to the current time
+//
+// Parameters:
+//
+//   - now: the current time
+//   - maximumAge: the maximum age of the timestamps, if zero the earliest timestamp is not checked
+//   - maximumSkew: the maximum time the timestamps can be ahead of the current time
+//
+// Returns:
+//
+//   - *TimeWindow: the time window
+func NewTimeWindow(now time.Time, maximumAge, maximumSkew time.Duration) *TimeWindow {
+	window := &TimeWindow{
+		NotAfter: now.Add(maximumSkew),
+	}
+	if maximumAge > 0 {
+		window.NotBefore = now.Add(-maximumAge)
+	}
+	return window
+}
+
+// Validate checks that a timestamp falls inside the time window
+//
+// Parameters:
+//
+//   - timestamp: the timestamp to check
+//
+// Returns:
+//
+//   - error: the validation error, if the timestamp is outside the time window
+func (t *TimeWindow) Validate(timestamp time.Time) error {
+	if t == nil {
+		return nil
+	}
+	if (!t.NotBefore.IsZero() && timestamp.Before(t.NotBefore)) ||
+		(!t.NotAfter.IsZero() && timestamp.After(t.NotAfter)) {
+		return ErrTimestampOutOfWindow.WithMetadata(
+			TimestampMetadataKey,
+			timestamp.UTC().Format(time.RFC3339Nano),
+		)
+	}
+	return nil
+}
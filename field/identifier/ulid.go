@@ -0,0 +1,71 @@
+package identifier
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// crockfordAlphabet is the Crockford base32 alphabet used by the ULIDs
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// ULIDLength is the length of a ULID
+	ULIDLength = 26
+)
+
+// ULIDTime returns the timestamp of a ULID
+//
+// Parameters:
+//
+//   - value: the ULID, case-insensitive
+//
+// Returns:
+//
+//   - time.Time: the ULID timestamp
+//   - error: the validation error, if the value is not a ULID
+func ULIDTime(value string) (time.Time, error) {
+	if len(value) != ULIDLength {
+		return time.Time{}, ErrInvalidULID
+	}
+	value = strings.ToUpper(value)
+
+	// The first character only encodes 3 bits, so it cannot be greater than '7'
+	if value[0] > '7' {
+		return time.Time{}, ErrInvalidULID
+	}
+
+	var milliseconds int64
+	for i := 0; i < ULIDLength; i++ {
+		index := strings.IndexByte(crockfordAlphabet, value[i])
+		if index < 0 {
+			return time.Time{}, ErrInvalidULID
+		}
+
+		// The first 10 characters encode the 48-bit timestamp in milliseconds
+		if i < 10 {
+			milliseconds = milliseconds<<5 | int64(index)
+		}
+	}
+	return time.UnixMilli(milliseconds).UTC(), nil
+}
+
+// ValidateULID validates a ULID and its timestamp
+//
+// Parameters:
+//
+//   - value: the ULID to validate, case-insensitive
+//   - window: the time window of the ULID timestamp (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the ULID is valid
+func ValidateULID(value string, window *TimeWindow) []error {
+	timestamp, err := ULIDTime(value)
+	if err != nil {
+		return []error{err}
+	}
+	if err = window.Validate(timestamp); err != nil {
+		return []error{err}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package identifier
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestULIDTime(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		want  time.Time
+		err   error
+	}{
+		{value: "01ARZ3NDEKTSV4RRFFQ69G5FAV", want: time.UnixMilli(1469922850259).UTC()},
+		{value: "01arz3ndektsv4rrffq69g5fav", want: time.UnixMilli(1469922850259).UTC()},
+		{value: "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", want: time.UnixMilli(1<<48 - 1).UTC()},
+		{value: "81ARZ3NDEKTSV4RRFFQ69G5FAV", err: ErrInvalidULID},
+		{value: "01ARZ3NDEKTSV4RRFFQ69G5FAU", err: ErrInvalidULID},
+		{value: "01ARZ3NDEKTSV4RRFFQ69G5FA", err: ErrInvalidULID},
+	} {
+		got, err := ULIDTime(tt.value)
+		if !errors.Is(err, tt.err) || !got.Equal(tt.want) {
+			t.Errorf("ULIDTime(%q) = %v, %v, want %v, %v", tt.value, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestValidateULID(t *testing.T) {
+	timestamp := time.UnixMilli(1469922850259)
+	for _, tt := range []struct {
+		name   string
+		value  string
+		window *TimeWindow
+		want   []error
+	}{
+		{name: "valid", value: "01ARZ3NDEKTSV4RRFFQ69G5FAV"},
+		{name: "invalid", value: "01ARZ3NDEKTSV4RRFFQ69G5FAU", want: []error{ErrInvalidULID}},
+		{
+			name:   "inside the time window",
+			value:  "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			window: &TimeWindow{NotBefore: timestamp, NotAfter: timestamp},
+		},
+		{
+			name:   "outside the time window",
+			value:  "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			window: &TimeWindow{NotBefore: timestamp.Add(time.Millisecond)},
+			want:   []error{ErrTimestampOutOfWindow},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := ValidateULID(tt.value, tt.window)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("ValidateULID(%q) = %v, want %v", tt.value, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("ValidateULID(%q) = %v, want %v", tt.value, errs, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,192 @@
+package identifier
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+const (
+	// gregorianToUnixOffset is the number of 100-nanosecond intervals between the Gregorian epoch, 1582-10-15, and
+	// the Unix epoch
+	gregorianToUnixOffset = 122192928000000000
+)
+
+// ParseUUID strictly parses a UUID in the canonical 8-4-4-4-12 hexadecimal format, case-insensitive, without braces
+// or the 'urn:uuid:' prefix
+//
+// Parameters:
+//
+//   - value: the UUID to parse
+//
+// Returns:
+//
+//   - [16]byte: the UUID bytes
+//   - error: the validation error, if the UUID is not in the canonical format
+func ParseUUID(value string) ([16]byte, error) {
+	var uuid [16]byte
+	if len(value) != 36 || value[8] != '-' || value[13] != '-' || value[18] != '-' || value[23] != '-' {
+		return uuid, ErrInvalidUUID
+	}
+
+	// Decode each group of hexadecimal digits
+	offset := 0
+	for _, group := range [][2]int{{0, 8}, {9, 13}, {14, 18}, {19, 23}, {24, 36}} {
+		n, err := hex.Decode(uuid[offset:], []byte(value[group[0]:group[1]]))
+		if err != nil {
+			return uuid, ErrInvalidUUID
+		}
+		offset += n
+	}
+	return uuid, nil
+}
+
+// UUIDVersion returns the version of a UUID
+//
+// Parameters:
+//
+//   - uuid: the UUID bytes
+//
+// Returns:
+//
+//   - int: the UUID version
+func UUIDVersion(uuid [16]byte) int {
+	return int(uuid[6] >> 4)
+}
+
+// UUIDVariant returns the variant of a UUID
+//
+// Parameters:
+//
+//   - uuid: the UUID bytes
+//
+// Returns:
+//
+//   - Variant: the UUID variant
+func UUIDVariant(uuid [16]byte) Variant {
+	switch {
+	case uuid[8]&0x80 == 0x00:
+		return VariantNCS
+	case uuid[8]&0xc0 == 0x80:
+		return VariantRFC9562
+	case uuid[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	}
+	return VariantFuture
+}
+
+// UUIDTime returns the timestamp of a time-ordered UUID
+//
+// Parameters:
+//
+//   - uuid: the UUID bytes
+//
+// Returns:
+//
+//   - time.Time: the UUID timestamp
+//   - bool: true if the UUID is a time-ordered RFC 9562 UUID of version 1, 6 or 7, false otherwise
+func UUIDTime(uuid [16]byte) (time.Time, bool) {
+	if UUIDVariant(uuid) != VariantRFC9562 {
+		return time.Time{}, false
+	}
+
+	var timestamp int64
+	switch UUIDVersion(uuid) {
+	case 1:
+		// time_low, time_mid and time_hi, in 100-nanosecond intervals since the Gregorian epoch
+		timestamp = int64(uuid[6]&0x0f)<<56 | int64(uuid[7])<<48 | int64(uuid[4])<<40 | int64(uuid[5])<<32 |
+			int64(uuid[0])<<24 | int64(uuid[1])<<16 | int64(uuid[2])<<8 | int64(uuid[3])
+	case 6:
+		// time_high, time_mid and time_low, in 100-nanosecond intervals since the Gregorian epoch
+		timestamp = int64(uuid[0])<<52 | int64(uuid[1])<<44 | int64(uuid[2])<<36 | int64(uuid[3])<<28 |
+			int64(uuid[4])<<20 | int64(uuid[5])<<12 | int64(uuid[6]&0x0f)<<8 | int64(uuid[7])
+	case 7:
+		// unix_ts_ms, in milliseconds since the Unix epoch
+		milliseconds := int64(uuid[0])<<40 | int64(uuid[1])<<32 | int64(uuid[2])<<24 | int64(uuid[3])<<16 |
+			int64(uuid[4])<<8 | int64(uuid[5])
+		return time.UnixMilli(milliseconds).UTC(), true
+	default:
+		return time.Time{}, false
+	}
+	return time.Unix(0, (timestamp-gregorianToUnixOffset)*100).UTC(), true
+}
+
+// ValidateUUID validates a UUID in the canonical format, its version and its variant
+//
+// Parameters:
+//
+//   - value: the UUID to validate
+//   - options: the UUID validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the UUID is valid
+func ValidateUUID(value string, options *UUIDOptions) []error {
+	if options == nil {
+		options = &UUIDOptions{}
+	}
+
+	uuid, err := ParseUUID(value)
+	if err != nil {
+		return []error{err}
+	}
+
+	// Check the nil and max UUIDs, which have no version nor variant
+	switch uuid {
+	case [16]byte{}:
+		if !options.AllowNil {
+			return []error{ErrNilUUIDNotAllowed}
+		}
+		return nil
+	case [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}:
+		if !options.AllowMax {
+			return []error{ErrMaxUUIDNotAllowed}
+		}
+		return nil
+	}
+
+	var errs []error
+
+	// Check the variant of the UUID
+	variant := UUIDVariant(uuid)
+	allowedVariants := options.Variants
+	if len(allowedVariants) == 0 {
+		allowedVariants = []Variant{VariantRFC9562}
+	}
+	isAllowedVariant := false
+	for _, allowedVariant := range allowedVariants {
+		if variant == allowedVariant {
+			isAllowedVariant = true
+			break
+		}
+	}
+	if !isAllowedVariant {
+		errs = append(errs, ErrUUIDVariantNotAllowed)
+	}
+
+	// Check the version of the UUID, which is only defined for the RFC 9562 variant
+	version := UUIDVersion(uuid)
+	if variant == VariantRFC9562 && len(options.Versions) > 0 {
+		isAllowedVersion := false
+		for _, allowedVersion := range options.Versions {
+			if version == allowedVersion {
+				isAllowedVersion = true
+				break
+			}
+		}
+		if !isAllowedVersion {
+			errs = append(
+				errs,
+				ErrUUIDVersionNotAllowed.WithMessage(fmt.Sprintf(ErrUUIDVersionNotAllowedMessage, version)),
+			)
+		}
+	}
+
+	// Check the timestamp of the time-ordered UUIDs
+	if timestamp, ok := UUIDTime(uuid); ok {
+		if err = options.Window.Validate(timestamp); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
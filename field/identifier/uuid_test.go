@@ -0,0 +1,155 @@
+package identifier
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseUUID(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		ok    bool
+	}{
+		{value: "f47ac10b-58cc-4372-a567-0e02b2c3d479", ok: true},
+		{value: "F47AC10B-58CC-4372-A567-0E02B2C3D479", ok: true},
+		{value: "{f47ac10b-58cc-4372-a567-0e02b2c3d479}"},
+		{value: "urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{value: "f47ac10b58cc4372a5670e02b2c3d479"},
+		{value: "f47ac10b-58cc-4372-a567-0e02b2c3d47"},
+		{value: "g47ac10b-58cc-4372-a567-0e02b2c3d479"},
+	} {
+		_, err := ParseUUID(tt.value)
+		if (err == nil) != tt.ok {
+			t.Errorf("ParseUUID(%q) = %v, want ok %v", tt.value, err, tt.ok)
+		}
+	}
+}
+
+func TestUUIDVersionAndVariant(t *testing.T) {
+	for _, tt := range []struct {
+		value   string
+		version int
+		variant Variant
+	}{
+		{value: "c232ab00-9414-11ec-b3c8-9f6bdeced846", version: 1, variant: VariantRFC9562},
+		{value: "f47ac10b-58cc-4372-a567-0e02b2c3d479", version: 4, variant: VariantRFC9562},
+		{value: "1ec9414c-232a-6b00-b3c8-9f6bdeced846", version: 6, variant: VariantRFC9562},
+		{value: "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", version: 7, variant: VariantRFC9562},
+		{value: "f47ac10b-58cc-4372-0567-0e02b2c3d479", version: 4, variant: VariantNCS},
+		{value: "f47ac10b-58cc-4372-c567-0e02b2c3d479", version: 4, variant: VariantMicrosoft},
+		{value: "f47ac10b-58cc-4372-e567-0e02b2c3d479", version: 4, variant: VariantFuture},
+	} {
+		uuid, err := ParseUUID(tt.value)
+		if err != nil {
+			t.Fatalf("ParseUUID(%q) = %v", tt.value, err)
+		}
+		if got := UUIDVersion(uuid); got != tt.version {
+			t.Errorf("UUIDVersion(%q) = %d, want %d", tt.value, got, tt.version)
+		}
+		if got := UUIDVariant(uuid); got != tt.variant {
+			t.Errorf("UUIDVariant(%q) = %d, want %d", tt.value, got, tt.variant)
+		}
+	}
+}
+
+func TestUUIDTime(t *testing.T) {
+	want := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+	for _, tt := range []struct {
+		value string
+		ok    bool
+	}{
+		{value: "c232ab00-9414-11ec-b3c8-9f6bdeced846", ok: true},
+		{value: "1ec9414c-232a-6b00-b3c8-9f6bdeced846", ok: true},
+		{value: "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", ok: true},
+		{value: "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+	} {
+		uuid, err := ParseUUID(tt.value)
+		if err != nil {
+			t.Fatalf("ParseUUID(%q) = %v", tt.value, err)
+		}
+		got, ok := UUIDTime(uuid)
+		if ok != tt.ok || (ok && !got.Equal(want)) {
+			t.Errorf("UUIDTime(%q) = %v, %v, want %v, %v", tt.value, got, ok, want, tt.ok)
+		}
+	}
+}
+
+func TestValidateUUID(t *testing.T) {
+	timestamp := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+	for _, tt := range []struct {
+		name    string
+		value   string
+		options *UUIDOptions
+		want    []error
+	}{
+		{name: "valid", value: "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{name: "invalid format", value: "f47ac10b58cc4372a5670e02b2c3d479", want: []error{ErrInvalidUUID}},
+		{
+			name:    "allowed version",
+			value:   "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+			options: &UUIDOptions{Versions: []int{4, 7}},
+		},
+		{
+			name:    "version not allowed",
+			value:   "c232ab00-9414-11ec-b3c8-9f6bdeced846",
+			options: &UUIDOptions{Versions: []int{4, 7}},
+			want:    []error{ErrUUIDVersionNotAllowed},
+		},
+		{
+			name:  "variant not allowed",
+			value: "f47ac10b-58cc-4372-c567-0e02b2c3d479",
+			want:  []error{ErrUUIDVariantNotAllowed},
+		},
+		{
+			name:    "allowed variant",
+			value:   "f47ac10b-58cc-4372-c567-0e02b2c3d479",
+			options: &UUIDOptions{Variants: []Variant{VariantMicrosoft}},
+		},
+		{
+			name:    "version of other variants not checked",
+			value:   "f47ac10b-58cc-1372-c567-0e02b2c3d479",
+			options: &UUIDOptions{Versions: []int{4}, Variants: []Variant{VariantMicrosoft}},
+		},
+		{name: "nil not allowed", value: "00000000-0000-0000-0000-000000000000", want: []error{ErrNilUUIDNotAllowed}},
+		{name: "nil allowed", value: "00000000-0000-0000-0000-000000000000", options: &UUIDOptions{AllowNil: true}},
+		{name: "max not allowed", value: "ffffffff-ffff-ffff-ffff-ffffffffffff", want: []error{ErrMaxUUIDNotAllowed}},
+		{name: "max allowed", value: "FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF", options: &UUIDOptions{AllowMax: true}},
+		{
+			name:    "inside the time window",
+			value:   "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+			options: &UUIDOptions{Window: NewTimeWindow(timestamp, time.Hour, time.Minute)},
+		},
+		{
+			name:    "older than the time window",
+			value:   "c232ab00-9414-11ec-b3c8-9f6bdeced846",
+			options: &UUIDOptions{Window: NewTimeWindow(timestamp.Add(2*time.Hour), time.Hour, time.Minute)},
+			want:    []error{ErrTimestampOutOfWindow},
+		},
+		{
+			name:    "ahead of the time window",
+			value:   "1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+			options: &UUIDOptions{Window: NewTimeWindow(timestamp.Add(-time.Hour), 0, time.Minute)},
+			want:    []error{ErrTimestampOutOfWindow},
+		},
+		{
+			name:    "time window not checked for random UUIDs",
+			value:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			options: &UUIDOptions{Window: NewTimeWindow(timestamp, time.Hour, time.Minute)},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := ValidateUUID(tt.value, tt.options)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("ValidateUUID(%q) = %v, want %v", tt.value, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("ValidateUUID(%q) = %v, want %v", tt.value, errs, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,129 @@
+package iso
+
+import (
+	"strings"
+)
+
+// CountryByAlpha2 returns a country by its ISO 3166-1 alpha-2 code
+//
+// Parameters:
+//
+//   - code: the alpha-2 code, case-insensitive
+//
+// Returns:
+//
+//   - Country: the country
+//   - bool: true if the country exists, false otherwise
+func CountryByAlpha2(code string) (Country, bool) {
+	country, ok := getTables().countriesByAlpha2[strings.ToUpper(code)]
+	return country, ok
+}
+
+// CountryByAlpha3 returns a country by its ISO 3166-1 alpha-3 code
+//
+// Parameters:
+//
+//   - code: the alpha-3 code, case-insensitive
+//
+// Returns:
+//
+//   - Country: the country
+//   - bool: true if the country exists, false otherwise
+func CountryByAlpha3(code string) (Country, bool) {
+	country, ok := getTables().countriesByAlpha3[strings.ToUpper(code)]
+	return country, ok
+}
+
+// CountryByNumeric returns a country by its ISO 3166-1 numeric code
+//
+// Parameters:
+//
+//   - code: the 3-digit numeric code, e.g. '032'
+//
+// Returns:
+//
+//   - Country: the country
+//   - bool: true if the country exists, false otherwise
+func CountryByNumeric(code string) (Country, bool) {
+	country, ok := getTables().countriesByNumeric[code]
+	return country, ok
+}
+
+// ValidateCountryAlpha2 validates an ISO 3166-1 alpha-2 country code
+//
+// Parameters:
+//
+//   - code: the country code to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the country code is valid
+func ValidateCountryAlpha2(code string, options *Options) []error {
+	country, ok := CountryByAlpha2(code)
+	if !ok {
+		return []error{ErrInvalidCountry}
+	}
+	if err := checkCase(code, country.Alpha2, "uppercase", options); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// ValidateCountryAlpha3 validates an ISO 3166-1 alpha-3 country code
+//
+// Parameters:
+//
+//   - code: the country code to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the country code is valid
+func ValidateCountryAlpha3(code string, options *Options) []error {
+	country, ok := CountryByAlpha3(code)
+	if !ok {
+		return []error{ErrInvalidCountry}
+	}
+	if err := checkCase(code, country.Alpha3, "uppercase", options); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// ValidateCountryNumeric validates an ISO 3166-1 numeric country code, with its leading zeros
+//
+// Parameters:
+//
+//   - code: the country code to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the country code is valid
+func ValidateCountryNumeric(code string) []error {
+	if _, ok := CountryByNumeric(code); !ok {
+		return []error{ErrInvalidCountry}
+	}
+	return nil
+}
+
+// ValidateSubdivision validates an ISO 3166-2 subdivision code, e.g. 'VE-A' or 'US-CA'
+//
+// Parameters:
+//
+//   - code: the subdivision code to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the subdivision code is valid
+func ValidateSubdivision(code string, options *Options) []error {
+	canonical := strings.ToUpper(code)
+	if _, ok := getTables().subdivisions[canonical]; !ok {
+		return []error{ErrInvalidSubdivision}
+	}
+	if err := checkCase(code, canonical, "uppercase", options); err != nil {
+		return []error{err}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package iso
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	ErrInvalidCaseMessage = "code must be written as %s"
+)
+
+var (
+	ErrInvalidCountry = govalidatorfield.NewError(
+		"iso.invalid_country",
+		"country must be an ISO 3166-1 country code",
+	)
+	ErrInvalidSubdivision = govalidatorfield.NewError(
+		"iso.invalid_subdivision",
+		"subdivision must be an ISO 3166-2 subdivision code",
+	)
+	ErrInvalidLanguage = govalidatorfield.NewError(
+		"iso.invalid_language",
+		"language must be an ISO 639 language code",
+	)
+	ErrInvalidLocale = govalidatorfield.NewError(
+		"iso.invalid_locale",
+		"locale must be a well-formed BCP 47 language tag",
+	)
+	ErrInvalidTimezone = govalidatorfield.NewError(
+		"iso.invalid_timezone",
+		"timezone must be an IANA time zone name",
+	)
+	ErrDeprecatedTimezone = govalidatorfield.NewError(
+		"iso.deprecated_timezone",
+		"timezone must be a canonical IANA time zone name",
+	)
+	ErrInvalidCase = govalidatorfield.NewError(
+		"iso.invalid_case",
+		"code is not written in its canonical case",
+	)
+)
@@ -0,0 +1,75 @@
+package iso
+
+import (
+	"strings"
+)
+
+// LanguageByAlpha2 returns a language by its ISO 639-1 code
+//
+// Parameters:
+//
+//   - code: the 2-letter code, case-insensitive
+//
+// Returns:
+//
+//   - Language: the language
+//   - bool: true if the language exists, false otherwise
+func LanguageByAlpha2(code string) (Language, bool) {
+	language, ok := getTables().languagesByAlpha2[strings.ToLower(code)]
+	return language, ok
+}
+
+// LanguageByAlpha3 returns a language by its ISO 639-2 terminology or bibliographic code
+//
+// Parameters:
+//
+//   - code: the 3-letter code, case-insensitive
+//
+// Returns:
+//
+//   - Language: the language
+//   - bool: true if the language exists, false otherwise
+func LanguageByAlpha3(code string) (Language, bool) {
+	language, ok := getTables().languagesByAlpha3[strings.ToLower(code)]
+	return language, ok
+}
+
+// ValidateLanguageAlpha2 validates an ISO 639-1 language code
+//
+// Parameters:
+//
+//   - code: the language code to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the language code is valid
+func ValidateLanguageAlpha2(code string, options *Options) []error {
+	if _, ok := LanguageByAlpha2(code); !ok {
+		return []error{ErrInvalidLanguage}
+	}
+	if err := checkCase(code, strings.ToLower(code), "lowercase", options); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// ValidateLanguageAlpha3 validates an ISO 639-2 language code, either the terminology or the bibliographic one
+//
+// Parameters:
+//
+//   - code: the language code to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the language code is valid
+func ValidateLanguageAlpha3(code string, options *Options) []error {
+	if _, ok := LanguageByAlpha3(code); !ok {
+		return []error{ErrInvalidLanguage}
+	}
+	if err := checkCase(code, strings.ToLower(code), "lowercase", options); err != nil {
+		return []error{err}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package iso
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// NormalizeLocale returns the canonical form of a BCP 47 language tag, e.g. 'es-VE' for 'ES-ve'
+//
+// Parameters:
+//
+//   - tag: the language tag to normalize
+//
+// Returns:
+//
+//   - string: the canonical language tag
+//   - error: the validation error, if the tag is not a well-formed BCP 47 language tag with known subtags
+func NormalizeLocale(tag string) (string, error) {
+	// BCP 47 only uses hyphens as separators
+	if tag == "" || strings.ContainsAny(tag, "_ ") {
+		return "", ErrInvalidLocale
+	}
+
+	parsedTag, err := language.Parse(tag)
+	if err != nil {
+		return "", ErrInvalidLocale
+	}
+	return parsedTag.String(), nil
+}
+
+// ValidateLocale validates a BCP 47 language tag, e.g. 'es-VE' or 'zh-Hant-TW'
+//
+// Parameters:
+//
+//   - tag: the language tag to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the language tag is valid
+func ValidateLocale(tag string, options *Options) []error {
+	canonical, err := NormalizeLocale(tag)
+	if err != nil {
+		return []error{err}
+	}
+
+	// Only check the case of the tags whose canonical form differs by case, deprecated subtags are replaced by the
+	// canonicalization
+	if strings.EqualFold(tag, canonical) {
+		if err = checkCase(tag, canonical, canonical, options); err != nil {
+			return []error{err}
+		}
+	}
+	return nil
+}
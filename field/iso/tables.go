@@ -0,0 +1,149 @@
+package iso
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type (
+	// tables are the parsed bundled reference data tables
+	tables struct {
+		countriesByAlpha2  map[string]Country
+		countriesByAlpha3  map[string]Country
+		countriesByNumeric map[string]Country
+		subdivisions       map[string]struct{}
+		languagesByAlpha2  map[string]Language
+		languagesByAlpha3  map[string]Language
+		timezones          map[string]string
+		timezonesByFold    map[string]string
+	}
+)
+
+var (
+	//go:embed data/countries.txt
+	countriesData string
+
+	//go:embed data/subdivisions.txt
+	subdivisionsData string
+
+	//go:embed data/languages.txt
+	languagesData string
+
+	//go:embed data/timezones.txt
+	timezonesData string
+
+	// defaultTables are the parsed bundled tables
+	defaultTables     *tables
+	defaultTablesOnce sync.Once
+)
+
+// getTables returns the parsed bundled tables, the embedded data is well-formed so it is parsed without errors
+//
+// Returns:
+//
+//   - *tables: the reference data tables
+func getTables() *tables {
+	defaultTablesOnce.Do(
+		func() {
+			t := &tables{
+				countriesByAlpha2:  make(map[string]Country),
+				countriesByAlpha3:  make(map[string]Country),
+				countriesByNumeric: make(map[string]Country),
+				subdivisions:       make(map[string]struct{}),
+				languagesByAlpha2:  make(map[string]Language),
+				languagesByAlpha3:  make(map[string]Language),
+				timezones:          make(map[string]string),
+				timezonesByFold:    make(map[string]string),
+			}
+
+			// Parse the countries, each line has the alpha-2, the alpha-3 and the numeric codes
+			forEachLine(
+				countriesData, func(fields []string) {
+					country := Country{Alpha2: fields[0], Alpha3: fields[1], Numeric: fields[2]}
+					t.countriesByAlpha2[country.Alpha2] = country
+					t.countriesByAlpha3[country.Alpha3] = country
+					t.countriesByNumeric[country.Numeric] = country
+				},
+			)
+
+			// Parse the subdivisions, each line has a subdivision code
+			forEachLine(
+				subdivisionsData, func(fields []string) {
+					t.subdivisions[fields[0]] = struct{}{}
+				},
+			)
+
+			// Parse the languages, each line has the terminology code, the alpha-2 code or '-', and the optional
+			// bibliographic code
+			forEachLine(
+				languagesData, func(fields []string) {
+					language := Language{Alpha3: fields[0]}
+					if fields[1] != "-" {
+						language.Alpha2 = fields[1]
+						t.languagesByAlpha2[language.Alpha2] = language
+					}
+					if len(fields) > 2 {
+						language.Bibliographic = fields[2]
+						t.languagesByAlpha3[language.Bibliographic] = language
+					}
+					t.languagesByAlpha3[language.Alpha3] = language
+				},
+			)
+
+			// Parse the timezones, each line has a zone name and, for the links, its target zone
+			forEachLine(
+				timezonesData, func(fields []string) {
+					target := fields[0]
+					if len(fields) > 1 {
+						target = fields[1]
+					}
+					t.timezones[fields[0]] = target
+					t.timezonesByFold[strings.ToLower(fields[0])] = fields[0]
+				},
+			)
+
+			defaultTables = t
+		},
+	)
+	return defaultTables
+}
+
+// forEachLine calls a function with the fields of each line of a table, ignoring the empty lines and the lines
+// starting with '#'
+//
+// Parameters:
+//
+//   - data: the table data
+//   - fn: the function to call
+func forEachLine(data string, fn func(fields []string)) {
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fn(strings.Fields(line))
+	}
+}
+
+// checkCase checks that a code is written in its canonical case, if the options are case-sensitive
+//
+// Parameters:
+//
+//   - code: the code
+//   - canonical: the code in its canonical case
+//   - caseName: the name of the canonical case, e.g. 'uppercase'
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - error: the validation error, if the code is not in its canonical case and the options are case-sensitive
+func checkCase(code, canonical, caseName string, options *Options) error {
+	if code == canonical || (options != nil && options.CaseInsensitive) {
+		return nil
+	}
+	return ErrInvalidCase.WithMessage(fmt.Sprintf(ErrInvalidCaseMessage, caseName))
+}
@@ -0,0 +1,49 @@
+package iso
+
+import (
+	"strings"
+)
+
+// CanonicalTimezone returns the canonical zone of an IANA time zone name, following the links
+//
+// Parameters:
+//
+//   - name: the time zone name, case-sensitive
+//
+// Returns:
+//
+//   - string: the canonical zone name, e.g. 'America/New_York' for 'US/Eastern'
+//   - bool: true if the time zone exists, false otherwise
+func CanonicalTimezone(name string) (string, bool) {
+	target, ok := getTables().timezones[name]
+	return target, ok
+}
+
+// ValidateTimezone validates an IANA time zone name, e.g. 'America/Caracas'
+//
+// Parameters:
+//
+//   - name: the time zone name to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the time zone name is valid
+func ValidateTimezone(name string, options *Options) []error {
+	t := getTables()
+
+	// Get the IANA spelling of the time zone name
+	canonical, ok := t.timezonesByFold[strings.ToLower(name)]
+	if !ok {
+		return []error{ErrInvalidTimezone}
+	}
+	if err := checkCase(name, canonical, canonical, options); err != nil {
+		return []error{err}
+	}
+
+	// Check if the time zone is a link
+	if options != nil && options.RejectTimezoneLinks && t.timezones[canonical] != canonical {
+		return []error{ErrDeprecatedTimezone}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package iso
+
+type (
+	// Options is the ISO reference data validation options struct
+	Options struct {
+		// CaseInsensitive accepts the codes in any case, otherwise they must be written in their canonical case, which
+		// is uppercase for the countries and the subdivisions, lowercase for the languages, the BCP 47 casing
+		// conventions for the locales, e.g. 'es-VE', and the IANA spelling for the timezones, e.g. 'America/Caracas'
+		CaseInsensitive bool
+
+		// RejectTimezoneLinks rejects the IANA timezone links, which are the deprecated or alias names of the
+		// canonical zones, e.g. 'US/Eastern'
+		RejectTimezoneLinks bool
+	}
+
+	// Country is an ISO 3166-1 country
+	Country struct {
+		// Alpha2 is the alpha-2 code of the country, e.g. 'VE'
+		Alpha2 string
+
+		// Alpha3 is the alpha-3 code of the country, e.g. 'VEN'
+		Alpha3 string
+
+		// Numeric is the numeric code of the country, e.g. '862'
+		Numeric string
+	}
+
+	// Language is an ISO 639 language
+	Language struct {
+		// Alpha3 is the ISO 639-2 terminology code of the language, e.g. 'deu'
+		Alpha3 string
+
+		// Alpha2 is the ISO 639-1 code of the language, empty if it has none, e.g. 'de'
+		Alpha2 string
+
+		// Bibliographic is the ISO 639-2 bibliographic code of the language, empty if it is the same as the
+		// terminology code, e.g. 'ger'
+		Bibliographic string
+	}
+)
+
+const (
+	// ISOCodesVersion is the version of the iso-codes data the bundled ISO 3166 and ISO 639 tables are taken from
+	ISOCodesVersion = "4.15.0"
+
+	// TZDataVersion is the version of the IANA time zone database the bundled timezones table is taken from
+	TZDataVersion = "2025b"
+)
@@ -0,0 +1,170 @@
+package mail
+
+import (
+	"net/mail"
+	"strings"
+)
+
+const (
+	// PlusSubaddressSeparator is the most common separator of the subaddress of the local part, e.g. 'john+tag'
+	PlusSubaddressSeparator = "+"
+
+	// HyphenSubaddressSeparator is the separator of the subaddress used by some providers, e.g. 'john-tag'
+	HyphenSubaddressSeparator = "-"
+)
+
+type (
+	// CanonicalRule is the provider-specific rule used to canonicalize the mail addresses of a domain
+	CanonicalRule struct {
+		// LowercaseLocalPart lowercases the local part, for providers with case-insensitive mailboxes
+		LowercaseLocalPart bool
+
+		// RemoveDots removes the dots of the local part, for providers that ignore them
+		RemoveDots bool
+
+		// SubaddressSeparator is the separator of the subaddress that is removed from the local part, if empty the
+		// subaddress is kept
+		SubaddressSeparator string
+
+		// CanonicalDomain replaces the domain, for providers with domain aliases (optional, can be empty)
+		CanonicalDomain string
+	}
+
+	// CanonicalRules is the table of the canonical rules, where the key is the domain
+	CanonicalRules map[string]CanonicalRule
+)
+
+// DefaultCanonicalRules returns the canonical rules of the most common mail providers, a new table is returned on each
+// call, so it can be modified by the caller
+//
+// Returns:
+//
+//   - CanonicalRules: the canonical rules
+func DefaultCanonicalRules() CanonicalRules {
+	gmail := CanonicalRule{
+		LowercaseLocalPart:  true,
+		RemoveDots:          true,
+		SubaddressSeparator: PlusSubaddressSeparator,
+		CanonicalDomain:     "gmail.com",
+	}
+	microsoft := CanonicalRule{
+		LowercaseLocalPart:  true,
+		SubaddressSeparator: PlusSubaddressSeparator,
+	}
+	apple := CanonicalRule{
+		LowercaseLocalPart:  true,
+		SubaddressSeparator: PlusSubaddressSeparator,
+	}
+	proton := CanonicalRule{
+		LowercaseLocalPart:  true,
+		SubaddressSeparator: PlusSubaddressSeparator,
+	}
+
+	return CanonicalRules{
+		"gmail.com":      gmail,
+		"googlemail.com": gmail,
+		"outlook.com":    microsoft,
+		"hotmail.com":    microsoft,
+		"live.com":       microsoft,
+		"msn.com":        microsoft,
+		"icloud.com":     apple,
+		"me.com":         apple,
+		"mac.com":        apple,
+		"protonmail.com": proton,
+		"proton.me":      proton,
+		"pm.me":          proton,
+		"fastmail.com": {
+			LowercaseLocalPart:  true,
+			SubaddressSeparator: PlusSubaddressSeparator,
+		},
+		"yahoo.com": {
+			LowercaseLocalPart:  true,
+			SubaddressSeparator: HyphenSubaddressSeparator,
+		},
+	}
+}
+
+// Lookup returns the rule of a domain, the keys of the table are normalized before they are compared, so they can
+// use any case or a trailing dot
+//
+// Parameters:
+//
+//   - domain: the normalized domain
+//
+// Returns:
+//
+//   - CanonicalRule: the rule of the domain
+//   - bool: true if the domain has a rule, false otherwise
+func (c CanonicalRules) Lookup(domain string) (CanonicalRule, bool) {
+	// Check the exact key first, since the default rules are already normalized
+	if rule, ok := c[domain]; ok {
+		return rule, true
+	}
+	for key, rule := range c {
+		if NormalizeDomain(key) == domain {
+			return rule, true
+		}
+	}
+	return CanonicalRule{}, false
+}
+
+// Canonicalize returns the canonical form of a mail address, so different spellings of the same mailbox can be
+// detected as duplicates. The domain is lowercased and IDNA-normalized, and the local part is transformed with the
+// rule of its domain, if any
+//
+// Parameters:
+//
+//   - address: the mail address to canonicalize
+//   - rules: the canonical rules (optional, can be nil)
+//
+// Returns:
+//
+//   - string: the canonical mail address
+//   - error: if the mail address is invalid
+func Canonicalize(address string, rules CanonicalRules) (string, error) {
+	// Parse the mail address, so display names and comments are removed
+	parsedAddress, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", ErrInvalidMailAddress
+	}
+	localPart, domain, ok := SplitAddress(parsedAddress.Address)
+	if !ok {
+		return "", ErrInvalidMailAddress
+	}
+
+	// Normalize the domain, domain literals are only lowercased
+	if strings.HasPrefix(domain, "[") {
+		domain = strings.ToLower(domain)
+	} else {
+		domain = NormalizeDomain(domain)
+	}
+
+	// Get the rule of the domain
+	rule, ok := rules.Lookup(domain)
+	if !ok {
+		return localPart + "@" + domain, nil
+	}
+
+	// Remove the subaddress
+	if rule.SubaddressSeparator != "" {
+		if index := strings.Index(localPart, rule.SubaddressSeparator); index > 0 {
+			localPart = localPart[:index]
+		}
+	}
+
+	// Remove the dots
+	if rule.RemoveDots {
+		localPart = strings.ReplaceAll(localPart, ".", "")
+	}
+
+	// Lowercase the local part
+	if rule.LowercaseLocalPart {
+		localPart = strings.ToLower(localPart)
+	}
+
+	// Replace the domain alias
+	if rule.CanonicalDomain != "" {
+		domain = NormalizeDomain(rule.CanonicalDomain)
+	}
+	return localPart + "@" + domain, nil
+}
@@ -0,0 +1,500 @@
+package mail
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultDeliverabilityTimeout is the default timeout of each DNS lookup
+	DefaultDeliverabilityTimeout = 3 * time.Second
+
+	// DefaultDeliverabilityCacheTTL is the default time a lookup result is cached
+	DefaultDeliverabilityCacheTTL = 10 * time.Minute
+
+	// DefaultDeliverabilityCacheSize is the default maximum number of cached lookup results
+	DefaultDeliverabilityCacheSize = 10000
+)
+
+type (
+	// NetResolver is the net.Resolver implementation of the Resolver interface
+	NetResolver struct {
+		resolver *net.Resolver
+	}
+
+	// MemoryResolver is an in-memory implementation of the Resolver interface, domains without records are reported
+	// as not found
+	MemoryResolver struct {
+		mutex sync.RWMutex
+		mx    map[string][]*net.MX
+		hosts map[string][]string
+		errs  map[string]error
+	}
+
+	// DeliverabilityOptions is the deliverability check options struct
+	DeliverabilityOptions struct {
+		// Timeout is the timeout of each DNS lookup, if zero DefaultDeliverabilityTimeout is used
+		Timeout time.Duration
+
+		// CacheTTL is the time a lookup result is cached, if zero DefaultDeliverabilityCacheTTL is used, if negative
+		// the results are not cached
+		CacheTTL time.Duration
+
+		// CacheSize is the maximum number of cached lookup results, if zero DefaultDeliverabilityCacheSize is used. When
+		// the cache is full, the expired results and then the oldest results are evicted
+		CacheSize int
+
+		// FailOpen accepts the domain when the lookup fails for reasons other than the domain not existing
+		FailOpen bool
+	}
+
+	// DeliverabilityChecker checks that a mail address domain has MX or A records. It is only run by the
+	// context-aware validations, such as ValidateWithContext, the plain validations skip it
+	DeliverabilityChecker struct {
+		resolver   Resolver
+		timeout    time.Duration
+		cacheTTL   time.Duration
+		cacheSize  int
+		failOpen   bool
+		mutex      sync.Mutex
+		cache      map[string]*list.Element
+		cacheOrder *list.List
+	}
+
+	// deliverabilityCacheEntry is a cached lookup result
+	deliverabilityCacheEntry struct {
+		domain    string
+		err       error
+		expiresAt time.Time
+	}
+)
+
+// NewNetResolver creates a new net.Resolver wrapper
+//
+// Parameters:
+//
+//   - resolver: the resolver to wrap (optional, if nil net.DefaultResolver is used)
+//
+// Returns:
+//
+//   - *NetResolver: the resolver
+func NewNetResolver(resolver *net.Resolver) *NetResolver {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &NetResolver{
+		resolver: resolver,
+	}
+}
+
+// LookupMX returns the MX records of the domain
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - domain: the domain to look up
+//
+// Returns:
+//
+//   - []*net.MX: the MX records
+//   - error: if the lookup failed
+func (n *NetResolver) LookupMX(ctx context.Context, domain string) ([]*net.MX, error) {
+	if n == nil {
+		return nil, ErrNilResolver
+	}
+	return n.resolver.LookupMX(ctx, domain)
+}
+
+// LookupHost returns the addresses of the domain
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - domain: the domain to look up
+//
+// Returns:
+//
+//   - []string: the addresses
+//   - error: if the lookup failed
+func (n *NetResolver) LookupHost(ctx context.Context, domain string) ([]string, error) {
+	if n == nil {
+		return nil, ErrNilResolver
+	}
+	return n.resolver.LookupHost(ctx, domain)
+}
+
+// NewMemoryResolver creates a new in-memory resolver
+//
+// Returns:
+//
+//   - *MemoryResolver: the in-memory resolver
+func NewMemoryResolver() *MemoryResolver {
+	return &MemoryResolver{
+		mx:    make(map[string][]*net.MX),
+		hosts: make(map[string][]string),
+		errs:  make(map[string]error),
+	}
+}
+
+// AddMX adds MX records to a domain
+//
+// Parameters:
+//
+//   - domain: the domain
+//   - hosts: the hosts of the MX records
+func (m *MemoryResolver) AddMX(domain string, hosts ...string) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	domain = NormalizeDomain(domain)
+	for i, host := range hosts {
+		m.mx[domain] = append(
+			m.mx[domain], &net.MX{
+				Host: host,
+				Pref: uint16(i * 10), //nolint:gosec
+			},
+		)
+	}
+}
+
+// AddHost adds addresses to a domain
+//
+// Parameters:
+//
+//   - domain: the domain
+//   - addresses: the addresses of the domain
+func (m *MemoryResolver) AddHost(domain string, addresses ...string) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	domain = NormalizeDomain(domain)
+	m.hosts[domain] = append(m.hosts[domain], addresses...)
+}
+
+// SetError sets the error returned by every lookup of a domain
+//
+// Parameters:
+//
+//   - domain: the domain
+//   - err: the error to return
+func (m *MemoryResolver) SetError(domain string, err error) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.errs[NormalizeDomain(domain)] = err
+}
+
+// LookupMX returns the MX records of the domain
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - domain: the domain to look up
+//
+// Returns:
+//
+//   - []*net.MX: the MX records
+//   - error: if the domain has no MX records or an error was set for it
+func (m *MemoryResolver) LookupMX(ctx context.Context, domain string) ([]*net.MX, error) {
+	if m == nil {
+		return nil, ErrNilResolver
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	domain = NormalizeDomain(domain)
+	if err, ok := m.errs[domain]; ok {
+		return nil, err
+	}
+	if records, ok := m.mx[domain]; ok {
+		return records, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: domain, IsNotFound: true}
+}
+
+// LookupHost returns the addresses of the domain
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - domain: the domain to look up
+//
+// Returns:
+//
+//   - []string: the addresses
+//   - error: if the domain has no addresses or an error was set for it
+func (m *MemoryResolver) LookupHost(ctx context.Context, domain string) ([]string, error) {
+	if m == nil {
+		return nil, ErrNilResolver
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	domain = NormalizeDomain(domain)
+	if err, ok := m.errs[domain]; ok {
+		return nil, err
+	}
+	if addresses, ok := m.hosts[domain]; ok {
+		return addresses, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: domain, IsNotFound: true}
+}
+
+// NewDeliverabilityChecker creates a new deliverability checker
+//
+// Parameters:
+//
+//   - resolver: the resolver used to look up the domains
+//   - options: the deliverability options (optional, can be nil)
+//
+// Returns:
+//
+//   - *DeliverabilityChecker: the deliverability checker
+//   - error: if the resolver is nil
+func NewDeliverabilityChecker(
+	resolver Resolver,
+	options *DeliverabilityOptions,
+) (*DeliverabilityChecker, error) {
+	// Check if the resolver is nil
+	if resolver == nil {
+		return nil, ErrNilResolver
+	}
+
+	// Set the default options
+	if options == nil {
+		options = &DeliverabilityOptions{}
+	}
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = DefaultDeliverabilityTimeout
+	}
+	cacheTTL := options.CacheTTL
+	if cacheTTL == 0 {
+		cacheTTL = DefaultDeliverabilityCacheTTL
+	}
+	cacheSize := options.CacheSize
+	if cacheSize <= 0 {
+		cacheSize = DefaultDeliverabilityCacheSize
+	}
+
+	return &DeliverabilityChecker{
+		resolver:   resolver,
+		timeout:    timeout,
+		cacheTTL:   cacheTTL,
+		cacheSize:  cacheSize,
+		failOpen:   options.FailOpen,
+		cache:      make(map[string]*list.Element),
+		cacheOrder: list.New(),
+	}, nil
+}
+
+// Check checks that the domain has MX records, or A/AAAA records when it has no MX records
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - domain: the domain to check
+//
+// Returns:
+//
+//   - error: the validation error, nil if the domain can receive mail or the lookup failed and the checker fails open.
+//     If the context is canceled, its error is returned
+func (d *DeliverabilityChecker) Check(ctx context.Context, domain string) error {
+	if d == nil {
+		return nil
+	}
+	domain = NormalizeDomain(domain)
+
+	// Check if the result is cached
+	if err, ok := d.getCached(domain); ok {
+		return err
+	}
+
+	// Look up the domain records
+	err, lookupErr := d.lookup(ctx, domain)
+	if lookupErr != nil {
+		// Check if the parent context was canceled
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		// The lookup results are not conclusive, so they are not cached
+		if d.failOpen {
+			return nil
+		}
+		return ErrDeliverabilityUnknown
+	}
+
+	d.setCached(domain, err)
+	return err
+}
+
+// lookup looks up the MX and host records of the domain
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - domain: the normalized domain
+//
+// Returns:
+//
+//   - error: the validation error, nil if the domain can receive mail
+//   - error: the lookup error, if the records could not be resolved
+func (d *DeliverabilityChecker) lookup(ctx context.Context, domain string) (error, error) { //nolint:revive
+	// Look up the MX records
+	mxCtx, mxCancel := context.WithTimeout(ctx, d.timeout)
+	records, err := d.resolver.LookupMX(mxCtx, domain)
+	mxCancel()
+	if err == nil && len(records) > 0 {
+		// Check if the domain has a null MX record, which means it does not accept mail
+		if len(records) == 1 && strings.TrimSuffix(records[0].Host, ".") == "" {
+			return ErrUndeliverableDomain, nil
+		}
+		return nil, nil
+	}
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	// Fall back to the host records
+	hostCtx, hostCancel := context.WithTimeout(ctx, d.timeout)
+	addresses, err := d.resolver.LookupHost(hostCtx, domain)
+	hostCancel()
+	if err == nil && len(addresses) > 0 {
+		return nil, nil
+	}
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	return ErrUndeliverableDomain, nil
+}
+
+// getCached returns the cached result of a domain
+//
+// Parameters:
+//
+//   - domain: the normalized domain
+//
+// Returns:
+//
+//   - error: the cached validation error
+//   - bool: true if there is a valid cached result, false otherwise
+func (d *DeliverabilityChecker) getCached(domain string) (error, bool) { //nolint:revive
+	if d.cacheTTL < 0 {
+		return nil, false
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	element, ok := d.cache[domain]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*deliverabilityCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		d.removeCached(element)
+		return nil, false
+	}
+	return entry.err, true
+}
+
+// setCached caches the result of a domain. The results are kept in insertion order, and since every result has the
+// same TTL, the oldest result is also the first to expire
+//
+// Parameters:
+//
+//   - domain: the normalized domain
+//   - err: the validation error
+func (d *DeliverabilityChecker) setCached(domain string, err error) {
+	if d.cacheTTL < 0 {
+		return
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	// Initialize the cache if it is nil
+	if d.cache == nil {
+		d.cache = make(map[string]*list.Element)
+	}
+	if d.cacheOrder == nil {
+		d.cacheOrder = list.New()
+	}
+
+	// Remove the previous result of the domain
+	if element, ok := d.cache[domain]; ok {
+		d.removeCached(element)
+	}
+
+	// Evict the expired results
+	now := time.Now()
+	for element := d.cacheOrder.Front(); element != nil; element = d.cacheOrder.Front() {
+		if !now.After(element.Value.(*deliverabilityCacheEntry).expiresAt) {
+			break
+		}
+		d.removeCached(element)
+	}
+
+	// Evict the oldest results if the cache is full
+	for d.cacheSize > 0 && d.cacheOrder.Len() >= d.cacheSize {
+		d.removeCached(d.cacheOrder.Front())
+	}
+
+	d.cache[domain] = d.cacheOrder.PushBack(
+		&deliverabilityCacheEntry{
+			domain:    domain,
+			err:       err,
+			expiresAt: now.Add(d.cacheTTL),
+		},
+	)
+}
+
+// removeCached removes a cached result, the mutex must be held
+//
+// Parameters:
+//
+//   - element: the element of the cached result
+func (d *DeliverabilityChecker) removeCached(element *list.Element) {
+	entry := d.cacheOrder.Remove(element).(*deliverabilityCacheEntry)
+	delete(d.cache, entry.domain)
+}
+
+// isNotFound checks if the lookup error means the domain has no records
+//
+// Parameters:
+//
+//   - err: the lookup error
+//
+// Returns:
+//
+//   - bool: true if the domain has no records, false otherwise
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
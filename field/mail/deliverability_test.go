@@ -0,0 +1,138 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestResolver creates an in-memory resolver with a few domains
+func newTestResolver() *MemoryResolver {
+	resolver := NewMemoryResolver()
+	resolver.AddMX("mx.example", "mail.mx.example.")
+	resolver.AddHost("host.example", "192.0.2.1")
+	resolver.AddMX("null.example", ".")
+	resolver.SetError("broken.example", errors.New("server misbehaving"))
+	return resolver
+}
+
+func TestDeliverabilityCheckerCheck(t *testing.T) {
+	resolver := newTestResolver()
+	for _, tt := range []struct {
+		name     string
+		domain   string
+		failOpen bool
+		want     error
+	}{
+		{name: "mx records", domain: "mx.example"},
+		{name: "mx records with different case", domain: "MX.Example"},
+		{name: "host fallback", domain: "host.example"},
+		{name: "null mx", domain: "null.example", want: ErrUndeliverableDomain},
+		{name: "not found", domain: "missing.example", want: ErrUndeliverableDomain},
+		{name: "lookup error fails closed", domain: "broken.example", want: ErrDeliverabilityUnknown},
+		{name: "lookup error fails open", domain: "broken.example", failOpen: true},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				checker, err := NewDeliverabilityChecker(
+					resolver,
+					&DeliverabilityOptions{FailOpen: tt.failOpen},
+				)
+				if err != nil {
+					t.Fatalf("NewDeliverabilityChecker returned error: %v", err)
+				}
+				if got := checker.Check(context.Background(), tt.domain); !errors.Is(got, tt.want) {
+					t.Errorf("Check(%q) = %v, want %v", tt.domain, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestDeliverabilityCheckerCanceledContext(t *testing.T) {
+	checker, err := NewDeliverabilityChecker(newTestResolver(), nil)
+	if err != nil {
+		t.Fatalf("NewDeliverabilityChecker returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got := checker.Check(ctx, "mx.example"); !errors.Is(got, context.Canceled) {
+		t.Errorf("Check with canceled context = %v, want %v", got, context.Canceled)
+	}
+}
+
+func TestDeliverabilityCheckerCache(t *testing.T) {
+	resolver := newTestResolver()
+	checker, err := NewDeliverabilityChecker(resolver, &DeliverabilityOptions{CacheSize: 2})
+	if err != nil {
+		t.Fatalf("NewDeliverabilityChecker returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	// Cache a negative result, then add records to the domain
+	if got := checker.Check(ctx, "new.example"); !errors.Is(got, ErrUndeliverableDomain) {
+		t.Fatalf("Check(new.example) = %v, want %v", got, ErrUndeliverableDomain)
+	}
+	resolver.AddMX("new.example", "mail.new.example.")
+	if got := checker.Check(ctx, "new.example"); !errors.Is(got, ErrUndeliverableDomain) {
+		t.Errorf("cached Check(new.example) = %v, want %v", got, ErrUndeliverableDomain)
+	}
+
+	// Fill the cache, so the oldest result is evicted
+	_ = checker.Check(ctx, "mx.example")
+	_ = checker.Check(ctx, "host.example")
+	if got := len(checker.cache); got != 2 {
+		t.Errorf("cache size = %d, want 2", got)
+	}
+	if got := checker.Check(ctx, "new.example"); got != nil {
+		t.Errorf("evicted Check(new.example) = %v, want nil", got)
+	}
+}
+
+func TestDeliverabilityCheckerCacheExpiration(t *testing.T) {
+	checker, err := NewDeliverabilityChecker(
+		newTestResolver(),
+		&DeliverabilityOptions{CacheTTL: time.Nanosecond},
+	)
+	if err != nil {
+		t.Fatalf("NewDeliverabilityChecker returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	// Every insert sweeps the expired results
+	for _, domain := range []string{"a.example", "b.example", "c.example", "mx.example"} {
+		_ = checker.Check(ctx, domain)
+		time.Sleep(time.Millisecond)
+	}
+	if got := len(checker.cache); got != 1 {
+		t.Errorf("cache size = %d, want 1", got)
+	}
+}
+
+func TestValidateWithContextDeliverability(t *testing.T) {
+	checker, err := NewDeliverabilityChecker(newTestResolver(), nil)
+	if err != nil {
+		t.Fatalf("NewDeliverabilityChecker returned error: %v", err)
+	}
+	options := &Options{Deliverability: checker}
+
+	// The plain validation skips the deliverability check
+	if errs := Validate("john@missing.example", options); len(errs) != 0 {
+		t.Errorf("Validate = %v, want nil", errs)
+	}
+
+	errs, err := ValidateWithContext(context.Background(), "john@missing.example", options)
+	if err != nil {
+		t.Fatalf("ValidateWithContext returned error: %v", err)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrUndeliverableDomain) {
+		t.Errorf("ValidateWithContext = %v, want [%v]", errs, ErrUndeliverableDomain)
+	}
+
+	errs, err = ValidateWithContext(context.Background(), "john@mx.example", options)
+	if err != nil || len(errs) != 0 {
+		t.Errorf("ValidateWithContext = %v, %v, want nil, nil", errs, err)
+	}
+}
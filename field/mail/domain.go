@@ -0,0 +1,211 @@
+package mail
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/idna"
+)
+
+type (
+	// DomainList is a set of domains matched at the suffix level, so 'example.com' also matches 'mail.example.com'
+	DomainList struct {
+		domains map[string]struct{}
+	}
+
+	// DomainPolicy is the mail address domain policy struct
+	DomainPolicy struct {
+		// Allowlist is the list of the only domains allowed (optional, can be nil)
+		Allowlist *DomainList
+
+		// Blocklist is the list of the domains not allowed (optional, can be nil)
+		Blocklist *DomainList
+
+		// Disposable is the list of disposable mailbox providers not allowed (optional, can be nil)
+		Disposable *DomainList
+	}
+)
+
+var (
+	//go:embed data/disposable_domains.txt
+	disposableDomainsData string
+
+	// disposableDomains is the parsed bundled disposable domains list
+	disposableDomains     *DomainList
+	disposableDomainsOnce sync.Once
+)
+
+// NormalizeDomain normalizes a domain to its lowercase ASCII form without the trailing dot
+//
+// Parameters:
+//
+//   - domain: the domain to normalize
+//
+// Returns:
+//
+//   - string: the normalized domain
+func NormalizeDomain(domain string) string {
+	domain = strings.TrimSuffix(strings.TrimSpace(domain), ".")
+	if asciiDomain, err := idna.Lookup.ToASCII(domain); err == nil {
+		domain = asciiDomain
+	}
+	return strings.ToLower(domain)
+}
+
+// NewDomainList creates a new domain list
+//
+// Parameters:
+//
+//   - domains: the domains of the list
+//
+// Returns:
+//
+//   - *DomainList: the domain list
+func NewDomainList(domains ...string) *DomainList {
+	d := &DomainList{
+		domains: make(map[string]struct{}, len(domains)),
+	}
+	d.Add(domains...)
+	return d
+}
+
+// LoadDomainList loads a domain list with one domain per line, empty lines and lines starting with '#' are ignored
+//
+// Parameters:
+//
+//   - reader: the reader of the domain list
+//
+// Returns:
+//
+//   - *DomainList: the domain list
+//   - error: if the domain list could not be read
+func LoadDomainList(reader io.Reader) (*DomainList, error) {
+	d := NewDomainList()
+
+	// Read the domains line by line
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		d.Add(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// DisposableDomains returns the bundled disposable mailbox providers list
+//
+// Returns:
+//
+//   - *DomainList: the disposable domains list
+func DisposableDomains() *DomainList {
+	disposableDomainsOnce.Do(
+		func() {
+			// The bundled list is embedded, so it cannot fail to be read
+			disposableDomains, _ = LoadDomainList(strings.NewReader(disposableDomainsData))
+		},
+	)
+	return disposableDomains
+}
+
+// Add adds domains to the list
+//
+// Parameters:
+//
+//   - domains: the domains to add
+func (d *DomainList) Add(domains ...string) {
+	if d == nil {
+		return
+	}
+
+	// Initialize the domains map if it is nil
+	if d.domains == nil {
+		d.domains = make(map[string]struct{}, len(domains))
+	}
+
+	for _, domain := range domains {
+		if domain = NormalizeDomain(domain); domain != "" {
+			d.domains[domain] = struct{}{}
+		}
+	}
+}
+
+// Contains checks if the domain or any of its parent domains is in the list
+//
+// Parameters:
+//
+//   - domain: the domain to check
+//
+// Returns:
+//
+//   - bool: true if the domain matches the list, false otherwise
+func (d *DomainList) Contains(domain string) bool {
+	if d == nil || len(d.domains) == 0 {
+		return false
+	}
+
+	// Check the domain and each of its parent domains
+	domain = NormalizeDomain(domain)
+	for domain != "" {
+		if _, ok := d.domains[domain]; ok {
+			return true
+		}
+
+		index := strings.Index(domain, ".")
+		if index < 0 {
+			break
+		}
+		domain = domain[index+1:]
+	}
+	return false
+}
+
+// Len returns the number of domains in the list
+//
+// Returns:
+//
+//   - int: the number of domains
+func (d *DomainList) Len() int {
+	if d == nil {
+		return 0
+	}
+	return len(d.domains)
+}
+
+// Validate validates a domain against the domain policy
+//
+// Parameters:
+//
+//   - domain: the domain to validate
+//
+// Returns:
+//
+//   - error: the validation error, nil if the domain is allowed
+func (d *DomainPolicy) Validate(domain string) error {
+	if d == nil {
+		return nil
+	}
+
+	// Check if the domain is in the allowlist
+	if d.Allowlist != nil && !d.Allowlist.Contains(domain) {
+		return ErrDomainNotAllowed
+	}
+
+	// Check if the domain is in the blocklist
+	if d.Blocklist.Contains(domain) {
+		return ErrDomainBlocked
+	}
+
+	// Check if the domain is a disposable mailbox provider
+	if d.Disposable.Contains(domain) {
+		return ErrDisposableDomain
+	}
+	return nil
+}
@@ -2,8 +2,89 @@ package mail
 
 import (
 	"errors"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	ErrDomainTypoSuggestion = "mail address domain looks misspelled, did you mean %s?"
+)
+
+var (
+	ErrNilResolver = errors.New("mail domain resolver cannot be nil")
 )
 
 var (
-	ErrInvalidMailAddress = errors.New("invalid mail address")
+	ErrInvalidMailAddress = govalidatorfield.NewError(
+		"mail.invalid",
+		"invalid mail address",
+	)
+	ErrNotAddrSpec = govalidatorfield.NewError(
+		"mail.not_addr_spec",
+		"mail address must be a plain address without comments or extra characters",
+	)
+	ErrDisplayNameNotAllowed = govalidatorfield.NewError(
+		"mail.display_name_not_allowed",
+		"mail address must not contain a display name",
+	)
+	ErrQuotedLocalPartNotAllowed = govalidatorfield.NewError(
+		"mail.quoted_local_part_not_allowed",
+		"mail address local part must not be quoted",
+	)
+	ErrInvalidLocalPart = govalidatorfield.NewError(
+		"mail.invalid_local_part",
+		"mail address local part is invalid",
+	)
+	ErrAddressTooLong = govalidatorfield.NewError(
+		"mail.address_too_long",
+		"mail address must not be longer than 254 characters",
+	)
+	ErrLocalPartTooLong = govalidatorfield.NewError(
+		"mail.local_part_too_long",
+		"mail address local part must not be longer than 64 characters",
+	)
+	ErrDomainTooLong = govalidatorfield.NewError(
+		"mail.domain_too_long",
+		"mail address domain must not be longer than 255 characters",
+	)
+	ErrDomainLabelTooLong = govalidatorfield.NewError(
+		"mail.domain_label_too_long",
+		"mail address domain labels must not be longer than 63 characters",
+	)
+	ErrInvalidIDNADomain = govalidatorfield.NewError(
+		"mail.invalid_idna_domain",
+		"mail address domain is not a valid internationalized domain name",
+	)
+	ErrMissingTLD = govalidatorfield.NewError(
+		"mail.missing_tld",
+		"mail address domain must have a top-level domain",
+	)
+	ErrInvalidTLD = govalidatorfield.NewError(
+		"mail.invalid_tld",
+		"mail address top-level domain is invalid",
+	)
+	ErrDomainNotAllowed = govalidatorfield.NewError(
+		"mail.domain_not_allowed",
+		"mail address domain is not allowed",
+	)
+	ErrDomainBlocked = govalidatorfield.NewError(
+		"mail.domain_blocked",
+		"mail address domain is blocked",
+	)
+	ErrDisposableDomain = govalidatorfield.NewError(
+		"mail.disposable_domain",
+		"mail address must not belong to a disposable mailbox provider",
+	)
+	ErrUndeliverableDomain = govalidatorfield.NewError(
+		"mail.undeliverable_domain",
+		"mail address domain cannot receive mail",
+	)
+	ErrDeliverabilityUnknown = govalidatorfield.NewError(
+		"mail.deliverability_unknown",
+		"mail address domain could not be verified",
+	)
+	ErrDomainTypo = govalidatorfield.NewError(
+		"mail.domain_typo",
+		"mail address domain looks misspelled",
+	)
 )
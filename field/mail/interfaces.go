@@ -0,0 +1,14 @@
+package mail
+
+import (
+	"context"
+	"net"
+)
+
+type (
+	// Resolver is an interface to look up the DNS records of a mail address domain
+	Resolver interface {
+		LookupMX(ctx context.Context, domain string) ([]*net.MX, error)
+		LookupHost(ctx context.Context, domain string) ([]string, error)
+	}
+)
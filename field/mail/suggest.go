@@ -0,0 +1,275 @@
+package mail
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	// DefaultSuggestionMaximumDistance is the default maximum edit distance between a domain and its suggestion, a
+	// larger distance mistakes real regional domains, like 'yahoo.de', for misspellings of others, like 'yahoo.es'
+	DefaultSuggestionMaximumDistance = 1
+
+	// SuggestionMetadataKey is the metadata key of the suggested mail address in the validation errors
+	SuggestionMetadataKey = "suggestion"
+)
+
+var (
+	// DefaultPopularDomains are the popular mail domains used to suggest fixes for misspelled domains. It also lists
+	// the real domains that are close to others, like 'ymail.com' and 'gmail.com', since the listed domains are never
+	// reported as misspelled
+	DefaultPopularDomains = []string{
+		"gmail.com",
+		"googlemail.com",
+		"yahoo.com",
+		"ymail.com",
+		"hotmail.com",
+		"outlook.com",
+		"icloud.com",
+		"aol.com",
+		"live.com",
+		"msn.com",
+		"me.com",
+		"mac.com",
+		"protonmail.com",
+		"proton.me",
+		"gmx.com",
+		"gmx.de",
+		"gmx.net",
+		"mail.com",
+		"yandex.com",
+		"zoho.com",
+		"fastmail.com",
+		"hotmail.es",
+		"hotmail.fr",
+		"hotmail.de",
+		"hotmail.it",
+		"yahoo.es",
+		"yahoo.fr",
+		"yahoo.de",
+		"yahoo.it",
+		"outlook.es",
+		"outlook.fr",
+		"outlook.de",
+		"live.fr",
+		"web.de",
+	}
+)
+
+type (
+	// DomainSuggester suggests popular domains for misspelled mail address domains, based on the edit distance
+	DomainSuggester struct {
+		domains         []string
+		maximumDistance int
+	}
+)
+
+// NewDomainSuggester creates a new domain suggester
+//
+// Parameters:
+//
+//   - domains: the popular domains to suggest (optional, if empty DefaultPopularDomains is used)
+//   - maximumDistance: the maximum edit distance of a suggestion, if zero or negative
+//     DefaultSuggestionMaximumDistance is used
+//
+// Returns:
+//
+//   - *DomainSuggester: the domain suggester
+func NewDomainSuggester(domains []string, maximumDistance int) *DomainSuggester {
+	if len(domains) == 0 {
+		domains = DefaultPopularDomains
+	}
+	if maximumDistance <= 0 {
+		maximumDistance = DefaultSuggestionMaximumDistance
+	}
+
+	// Normalize the domains
+	normalizedDomains := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		if domain = NormalizeDomain(domain); domain != "" {
+			normalizedDomains = append(normalizedDomains, domain)
+		}
+	}
+
+	return &DomainSuggester{
+		domains:         normalizedDomains,
+		maximumDistance: maximumDistance,
+	}
+}
+
+// Suggest returns the closest popular domain to the given domain
+//
+// Parameters:
+//
+//   - domain: the domain to check
+//
+// Returns:
+//
+//   - string: the suggested domain
+//   - bool: true if the domain looks like a misspelling of a popular domain, false otherwise
+func (d *DomainSuggester) Suggest(domain string) (string, bool) {
+	if d == nil {
+		return "", false
+	}
+	domain = NormalizeDomain(domain)
+	if domain == "" {
+		return "", false
+	}
+
+	// Get the closest domain, an exact match means the domain is not misspelled
+	bestDomain := ""
+	bestDistance := d.maximumDistance + 1
+	for _, popularDomain := range d.domains {
+		if popularDomain == domain {
+			return "", false
+		}
+		if distance := editDistance(domain, popularDomain); distance < bestDistance {
+			bestDomain = popularDomain
+			bestDistance = distance
+		}
+	}
+	return bestDomain, bestDomain != ""
+}
+
+// SuggestAddress returns the mail address with its domain replaced by the closest popular domain
+//
+// Parameters:
+//
+//   - address: the mail address to check
+//
+// Returns:
+//
+//   - string: the suggested mail address
+//   - bool: true if the domain looks like a misspelling of a popular domain, false otherwise
+func (d *DomainSuggester) SuggestAddress(address string) (string, bool) {
+	// Remove the display name and comments, if the address can be parsed
+	address = strings.TrimSpace(address)
+	if parsedAddress, err := mail.ParseAddress(address); err == nil {
+		address = parsedAddress.Address
+	}
+
+	localPart, domain, ok := SplitAddress(address)
+	if !ok {
+		return "", false
+	}
+
+	suggestedDomain, ok := d.Suggest(domain)
+	if !ok {
+		return "", false
+	}
+	return localPart + "@" + suggestedDomain, true
+}
+
+// withSuggestion attaches the suggested mail address to the validation errors, if any. The suggestion of an otherwise
+// valid address is reported to the suggestion handler, and only returned as ErrDomainTypo if it is opted in
+//
+// Parameters:
+//
+//   - errs: the validation errors
+//   - address: the mail address
+//   - suggester: the domain suggester (optional, can be nil)
+//   - handler: the handler of the suggestions for valid addresses (optional, can be nil)
+//   - report: determines if the suggestion of a valid address is returned as ErrDomainTypo
+//
+// Returns:
+//
+//   - []error: the validation errors, with the suggestion attached when the address domain looks misspelled
+func withSuggestion(
+	errs []error,
+	address string,
+	suggester *DomainSuggester,
+	handler func(address, suggestion string),
+	report bool,
+) []error {
+	suggestion, ok := suggester.SuggestAddress(address)
+	if !ok {
+		return errs
+	}
+
+	// If the address is otherwise valid, report the suggestion to the handler and, if opted in, as an error
+	if len(errs) == 0 {
+		if handler != nil {
+			handler(address, suggestion)
+		}
+		if report {
+			return []error{NewDomainTypoError(suggestion)}
+		}
+		return nil
+	}
+
+	// Attach the suggestion to the coded errors
+	suggestedErrs := make([]error, len(errs))
+	for i, err := range errs {
+		if fieldErr, isFieldErr := err.(*govalidatorfield.Error); isFieldErr {
+			suggestedErrs[i] = fieldErr.WithMetadata(SuggestionMetadataKey, suggestion)
+		} else {
+			suggestedErrs[i] = err
+		}
+	}
+	return suggestedErrs
+}
+
+// NewDomainTypoError creates the validation error of a misspelled domain, with the suggested mail address in its
+// message and metadata. It is returned by Validate for the otherwise valid addresses when Options.ReportDomainTypos
+// is set
+//
+// Parameters:
+//
+//   - suggestion: the suggested mail address
+//
+// Returns:
+//
+//   - *govalidatorfield.Error: the validation error
+func NewDomainTypoError(suggestion string) *govalidatorfield.Error {
+	return ErrDomainTypo.
+		WithMessage(fmt.Sprintf(ErrDomainTypoSuggestion, suggestion)).
+		WithMetadata(SuggestionMetadataKey, suggestion)
+}
+
+// editDistance returns the optimal string alignment distance between two strings, which counts insertions,
+// deletions, substitutions and transpositions of adjacent characters
+//
+// Parameters:
+//
+//   - a: the first string
+//   - b: the second string
+//
+// Returns:
+//
+//   - int: the edit distance
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Initialize the distance matrix
+	distances := make([][]int, len(ra)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(rb)+1)
+		distances[i][0] = i
+	}
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			distances[i][j] = min(
+				distances[i-1][j]+1,
+				distances[i][j-1]+1,
+				distances[i-1][j-1]+cost,
+			)
+
+			// Check for a transposition of adjacent characters
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				distances[i][j] = min(distances[i][j], distances[i-2][j-2]+1)
+			}
+		}
+	}
+	return distances[len(ra)][len(rb)]
+}
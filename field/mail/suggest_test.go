@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"errors"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestDomainSuggesterSuggest(t *testing.T) {
+	suggester := NewDomainSuggester(nil, 0)
+	for _, tt := range []struct {
+		domain string
+		want   string
+	}{
+		{domain: "gmial.com", want: "gmail.com"},
+		{domain: "hotmial.com", want: "hotmail.com"},
+		{domain: "GMAIL.CON", want: "gmail.com"},
+		{domain: "gmail.com"},
+		{domain: "ymail.com"},
+		{domain: "gmx.net"},
+		{domain: "yahoo.de"},
+		{domain: "hotmail.fr"},
+		{domain: "mac.com"},
+		{domain: "outlook.fr"},
+		{domain: "example.org"},
+	} {
+		got, ok := suggester.Suggest(tt.domain)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Errorf("Suggest(%q) = %q, %v, want %q", tt.domain, got, ok, tt.want)
+		}
+	}
+}
+
+func TestValidateSuggestionIsAdvisory(t *testing.T) {
+	var suggestions []string
+	options := &Options{
+		Suggester: NewDomainSuggester(nil, 0),
+		SuggestionHandler: func(_, suggestion string) {
+			suggestions = append(suggestions, suggestion)
+		},
+	}
+
+	// A valid address is never rejected, the suggestion is only reported to the handler
+	if errs := Validate("john@gmial.com", options); len(errs) != 0 {
+		t.Errorf("Validate(john@gmial.com) = %v, want nil", errs)
+	}
+	if len(suggestions) != 1 || suggestions[0] != "john@gmail.com" {
+		t.Errorf("suggestions = %v, want [john@gmail.com]", suggestions)
+	}
+
+	// An invalid address gets the suggestion in the metadata of its errors
+	options.Mode = ModeStrict
+	errs := Validate("john doe@gmial.com", options)
+	if len(errs) == 0 {
+		t.Fatal("Validate(john doe@gmial.com) = nil, want errors")
+	}
+	for _, err := range errs {
+		var fieldErr *govalidatorfield.Error
+		if !errors.As(err, &fieldErr) {
+			continue
+		}
+		if got := fieldErr.Metadata()[SuggestionMetadataKey]; got != "john doe@gmail.com" {
+			t.Errorf("suggestion metadata = %q, want %q", got, "john doe@gmail.com")
+		}
+	}
+}
+
+func TestValidateReportDomainTypos(t *testing.T) {
+	options := &Options{Suggester: NewDomainSuggester(nil, 0), ReportDomainTypos: true}
+
+	errs := Validate("john@gmial.com", options)
+	if len(errs) != 1 || !errors.Is(errs[0], ErrDomainTypo) {
+		t.Fatalf("Validate(john@gmial.com) = %v, want [%v]", errs, ErrDomainTypo)
+	}
+	if got := govalidatorfield.GetMetadata(errs[0])[SuggestionMetadataKey]; got != "john@gmail.com" {
+		t.Errorf("suggestion metadata = %q, want %q", got, "john@gmail.com")
+	}
+	if got, _ := govalidatorfield.GetCode(errs[0]); got != "mail.domain_typo" {
+		t.Errorf("code = %q, want %q", got, "mail.domain_typo")
+	}
+
+	if errs = Validate("john@gmail.com", options); len(errs) != 0 {
+		t.Errorf("Validate(john@gmail.com) = %v, want nil", errs)
+	}
+}
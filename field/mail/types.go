@@ -0,0 +1,77 @@
+package mail
+
+type (
+	// Mode is a bit set of the strict validations applied to a mail address
+	Mode uint
+
+	// Options is the mail address validation options struct
+	Options struct {
+		// Mode is the set of strict validations, if it is zero only net/mail.ParseAddress is used
+		Mode Mode
+
+		// DomainPolicy is the policy of the allowed and blocked domains (optional, can be nil)
+		DomainPolicy *DomainPolicy
+
+		// Deliverability checks that the domain has MX or A records (optional, can be nil).
+		//
+		// It only runs through ValidateWithContext, Validate skips it since it requires DNS lookups
+		Deliverability *DeliverabilityChecker
+
+		// Suggester suggests a fix for misspelled domains, attaching it to the metadata of the validation errors
+		// (optional, can be nil)
+		Suggester *DomainSuggester
+
+		// SuggestionHandler is called with the suggestion of an otherwise valid address, since a suggestion does not
+		// fail the validation unless ReportDomainTypos is set, e.g. to show it as a warning (optional, can be nil)
+		SuggestionHandler func(address, suggestion string)
+
+		// ReportDomainTypos determines if the suggestion of an otherwise valid address is returned as ErrDomainTypo,
+		// with the suggestion in its metadata, so it reaches the field validations. The clients can tell it apart by
+		// its 'mail.domain_typo' code, e.g. to ask the user to confirm the address
+		ReportDomainTypos bool
+	}
+)
+
+const (
+	// ModeAddrSpec only accepts plain addr-spec addresses, without display names, comments or quoted local parts
+	ModeAddrSpec Mode = 1 << iota
+
+	// ModeLengthLimits enforces the RFC 5321 length limits of the address, the local part and the domain
+	ModeLengthLimits
+
+	// ModeIDNA checks that the domain is a valid internationalized domain name
+	ModeIDNA
+
+	// ModeRequireTLD requires the domain to have a valid top-level domain
+	ModeRequireTLD
+
+	// ModeStrict enables every strict validation
+	ModeStrict = ModeAddrSpec | ModeLengthLimits | ModeIDNA | ModeRequireTLD
+)
+
+const (
+	// MaximumAddressLength is the maximum length of a mail address, as defined by RFC 5321 forward-path limit
+	MaximumAddressLength = 254
+
+	// MaximumLocalPartLength is the maximum length of the local part of a mail address
+	MaximumLocalPartLength = 64
+
+	// MaximumDomainLength is the maximum length of the domain of a mail address
+	MaximumDomainLength = 255
+
+	// MaximumDomainLabelLength is the maximum length of each label of the domain of a mail address
+	MaximumDomainLabelLength = 63
+)
+
+// Has checks if the mode contains the given validations
+//
+// Parameters:
+//
+//   - mode: the validations to check
+//
+// Returns:
+//
+//   - bool: true if every given validation is enabled, false otherwise
+func (m Mode) Has(mode Mode) bool {
+	return m&mode == mode
+}
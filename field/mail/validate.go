@@ -0,0 +1,326 @@
+package mail
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	// atextSpecialCharacters are the non-alphanumeric characters allowed in a dot-atom local part
+	atextSpecialCharacters = "!#$%&'*+-/=?^_`{|}~"
+)
+
+// SplitAddress splits a plain mail address into its local part and its domain
+//
+// Parameters:
+//
+//   - address: the mail address to split
+//
+// Returns:
+//
+//   - string: the local part of the address
+//   - string: the domain of the address
+//   - bool: true if the address contains a non-empty local part and domain, false otherwise
+func SplitAddress(address string) (localPart, domain string, ok bool) {
+	index := strings.LastIndex(address, "@")
+	if index <= 0 || index == len(address)-1 {
+		return "", "", false
+	}
+	return address[:index], address[index+1:], true
+}
+
+// Validate validates a mail address.
+//
+// It does not check the domain deliverability, even if the options have a deliverability checker, use
+// ValidateWithContext instead
+//
+// Parameters:
+//
+//   - address: the mail address to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the address is valid
+func Validate(address string, options *Options) []error {
+	errs := validate(address, options)
+
+	// Suggest a fix for misspelled domains
+	if options == nil || options.Suggester == nil {
+		return errs
+	}
+	return withSuggestion(errs, address, options.Suggester, options.SuggestionHandler, options.ReportDomainTypos)
+}
+
+// validate validates a mail address without suggesting fixes
+//
+// Parameters:
+//
+//   - address: the mail address to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the address is valid
+func validate(address string, options *Options) []error {
+	// Check if the mail address is empty
+	if address == "" {
+		return []error{ErrInvalidMailAddress}
+	}
+
+	// Check if the mail address is valid
+	parsedAddress, err := mail.ParseAddress(address)
+	if err != nil {
+		return []error{ErrInvalidMailAddress}
+	}
+
+	// Check if there are validation options to apply
+	if options == nil {
+		return nil
+	}
+	mode := options.Mode
+
+	// Check if the mail address is a plain addr-spec
+	if mode.Has(ModeAddrSpec) {
+		if err = validateAddrSpec(address, parsedAddress); err != nil {
+			return []error{err}
+		}
+	}
+
+	// Split the parsed mail address
+	localPart, domain, ok := SplitAddress(parsedAddress.Address)
+	if !ok {
+		return []error{ErrInvalidMailAddress}
+	}
+
+	// Convert the domain to its ASCII form
+	asciiDomain := domain
+	if mode.Has(ModeIDNA) {
+		if strings.HasPrefix(domain, "[") {
+			return []error{ErrInvalidIDNADomain}
+		}
+		asciiDomain, err = idna.Lookup.ToASCII(domain)
+		if err != nil {
+			return []error{ErrInvalidIDNADomain}
+		}
+	}
+
+	var errs []error
+
+	// Check the RFC 5321 length limits
+	if mode.Has(ModeLengthLimits) {
+		errs = append(errs, validateLengthLimits(localPart, asciiDomain)...)
+	}
+
+	// Check the top-level domain
+	if mode.Has(ModeRequireTLD) {
+		if err = validateTLD(asciiDomain); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Check the domain policy
+	if err = options.DomainPolicy.Validate(asciiDomain); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// ValidateWithContext validates a mail address and, if the other validations passed, checks its domain
+// deliverability
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - address: the mail address to validate
+//   - options: the validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the address is valid
+//   - error: if the context was canceled during the deliverability check
+func ValidateWithContext(
+	ctx context.Context,
+	address string,
+	options *Options,
+) ([]error, error) {
+	// Validate the mail address
+	errs := Validate(address, options)
+	if len(errs) > 0 || options == nil || options.Deliverability == nil {
+		return errs, nil
+	}
+
+	// Get the domain of the mail address
+	parsedAddress, err := mail.ParseAddress(address)
+	if err != nil {
+		return []error{ErrInvalidMailAddress}, nil
+	}
+	_, domain, ok := SplitAddress(parsedAddress.Address)
+	if !ok {
+		return []error{ErrInvalidMailAddress}, nil
+	}
+
+	// Domain literals are not looked up
+	if strings.HasPrefix(domain, "[") {
+		return nil, nil
+	}
+
+	// Check the domain deliverability
+	if err = options.Deliverability.Check(ctx, domain); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return []error{err}, nil
+	}
+	return nil, nil
+}
+
+// validateAddrSpec checks that the mail address is a plain addr-spec
+//
+// Parameters:
+//
+//   - address: the raw mail address
+//   - parsedAddress: the parsed mail address
+//
+// Returns:
+//
+//   - error: the validation error, nil if the address is a plain addr-spec
+func validateAddrSpec(address string, parsedAddress *mail.Address) error {
+	// Check if the mail address has a display name
+	if strings.ContainsAny(address, "<>") {
+		return ErrDisplayNameNotAllowed
+	}
+
+	// Check if the local part is quoted
+	if strings.HasPrefix(address, "\"") {
+		return ErrQuotedLocalPartNotAllowed
+	}
+
+	// Check if the mail address has comments or surrounding characters
+	if parsedAddress.Name != "" || parsedAddress.Address != address {
+		return ErrNotAddrSpec
+	}
+
+	// Check if the local part is a dot-atom
+	localPart, _, ok := SplitAddress(address)
+	if !ok || !isDotAtom(localPart) {
+		return ErrInvalidLocalPart
+	}
+	return nil
+}
+
+// isDotAtom checks if the local part is a dot-atom, allowing UTF-8 characters as defined by RFC 6532
+//
+// Parameters:
+//
+//   - localPart: the local part to check
+//
+// Returns:
+//
+//   - bool: true if the local part is a dot-atom, false otherwise
+func isDotAtom(localPart string) bool {
+	if localPart == "" || strings.HasPrefix(localPart, ".") || strings.HasSuffix(localPart, ".") {
+		return false
+	}
+	if strings.Contains(localPart, "..") {
+		return false
+	}
+
+	for _, r := range localPart {
+		switch {
+		case r == '.':
+		case r >= utf8.RuneSelf:
+			if !unicode.IsPrint(r) || unicode.IsSpace(r) {
+				return false
+			}
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune(atextSpecialCharacters, r):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// validateLengthLimits checks the RFC 5321 length limits of the mail address
+//
+// Parameters:
+//
+//   - localPart: the local part of the mail address
+//   - domain: the ASCII domain of the mail address
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the limits are satisfied
+func validateLengthLimits(localPart, domain string) []error {
+	var errs []error
+	if len(localPart)+1+len(domain) > MaximumAddressLength {
+		errs = append(errs, ErrAddressTooLong)
+	}
+	if len(localPart) > MaximumLocalPartLength {
+		errs = append(errs, ErrLocalPartTooLong)
+	}
+	if len(domain) > MaximumDomainLength {
+		errs = append(errs, ErrDomainTooLong)
+	}
+
+	// Domain literals have no labels
+	if strings.HasPrefix(domain, "[") {
+		return errs
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) > MaximumDomainLabelLength {
+			errs = append(errs, ErrDomainLabelTooLong)
+			break
+		}
+	}
+	return errs
+}
+
+// validateTLD checks that the domain has a valid top-level domain
+//
+// Parameters:
+//
+//   - domain: the domain of the mail address
+//
+// Returns:
+//
+//   - error: the validation error, nil if the top-level domain is valid
+func validateTLD(domain string) error {
+	// Domain literals and single label domains have no top-level domain
+	if strings.HasPrefix(domain, "[") {
+		return ErrMissingTLD
+	}
+	domain = strings.TrimSuffix(domain, ".")
+	index := strings.LastIndex(domain, ".")
+	if index <= 0 {
+		return ErrMissingTLD
+	}
+	tld := domain[index+1:]
+
+	// Check if the top-level domain is an A-label
+	if strings.HasPrefix(strings.ToLower(tld), "xn--") {
+		for _, r := range tld {
+			if !(r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+				return ErrInvalidTLD
+			}
+		}
+		return nil
+	}
+
+	// Check if the top-level domain only contains letters
+	if utf8.RuneCountInString(tld) < 2 {
+		return ErrInvalidTLD
+	}
+	for _, r := range tld {
+		if !unicode.IsLetter(r) {
+			return ErrInvalidTLD
+		}
+	}
+	return nil
+}
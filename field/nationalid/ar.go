@@ -0,0 +1,40 @@
+package nationalid
+
+var (
+	// cuitWeights are the weights of the first 10 digits of a CUIT
+	cuitWeights = []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+
+	// cuitPrefixes are the valid person type prefixes of a CUIT
+	cuitPrefixes = map[string]struct{}{
+		"20": {}, "23": {}, "24": {}, "25": {}, "26": {}, "27": {}, "30": {}, "33": {}, "34": {},
+	}
+)
+
+// ValidateCUIT validates an Argentine CUIT or CUIL, which has a 2-digit person type, 8 digits and a check digit, e.g.
+// '20-12345678-6'
+//
+// Parameters:
+//
+//   - number: the normalized CUIT
+//
+// Returns:
+//
+//   - error: the validation error, if the CUIT is not valid
+func ValidateCUIT(number string) error {
+	if len(number) != 11 || !isDigits(number) {
+		return ErrInvalidFormat
+	}
+	if _, ok := cuitPrefixes[number[:2]]; !ok {
+		return ErrInvalidFormat
+	}
+
+	// A check digit of 10 is never assigned, the person type prefix is changed instead
+	checkDigit := 11 - weightedSum(number, cuitWeights)%11
+	if checkDigit == 11 {
+		checkDigit = 0
+	}
+	if checkDigit == 10 || int(number[10]-'0') != checkDigit {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package nationalid
+
+var (
+	// cnpjWeights are the weights of the CNPJ check digits, the first check digit uses the last 12 weights
+	cnpjWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+// ValidateCPF validates a Brazilian CPF, which has 9 digits and 2 check digits, e.g. '529.982.247-25'
+//
+// Parameters:
+//
+//   - number: the normalized CPF
+//
+// Returns:
+//
+//   - error: the validation error, if the CPF is not valid
+func ValidateCPF(number string) error {
+	if len(number) != 11 || !isDigits(number) {
+		return ErrInvalidFormat
+	}
+	if allSameDigit(number) {
+		return ErrInvalidChecksum
+	}
+
+	// Each check digit uses the previous digits with decreasing weights, starting at the number of digits plus one
+	for length := 9; length <= 10; length++ {
+		sum := 0
+		for i := 0; i < length; i++ {
+			sum += int(number[i]-'0') * (length + 1 - i)
+		}
+		checkDigit := sum * 10 % 11 % 10
+		if int(number[length]-'0') != checkDigit {
+			return ErrInvalidChecksum
+		}
+	}
+	return nil
+}
+
+// ValidateCNPJ validates a Brazilian CNPJ, which has 12 characters and 2 check digits, e.g. '11.222.333/0001-81'. The
+// alphanumeric CNPJs, whose first 12 characters may be uppercase letters, are also accepted
+//
+// Parameters:
+//
+//   - number: the normalized CNPJ
+//
+// Returns:
+//
+//   - error: the validation error, if the CNPJ is not valid
+func ValidateCNPJ(number string) error {
+	if len(number) != 14 || !isUpperAlphanumeric(number[:12]) || !isDigits(number[12:]) {
+		return ErrInvalidFormat
+	}
+	if allSameDigit(number) {
+		return ErrInvalidChecksum
+	}
+
+	// The value of each character is its ASCII code minus 48, so the digits keep their value
+	for length := 12; length <= 13; length++ {
+		sum := 0
+		weights := cnpjWeights[13-length:]
+		for i := 0; i < length; i++ {
+			sum += int(number[i]-'0') * weights[i]
+		}
+		checkDigit := 0
+		if remainder := sum % 11; remainder >= 2 {
+			checkDigit = 11 - remainder
+		}
+		if int(number[length]-'0') != checkDigit {
+			return ErrInvalidChecksum
+		}
+	}
+	return nil
+}
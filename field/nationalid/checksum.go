@@ -0,0 +1,80 @@
+package nationalid
+
+// isDigits checks if a string only contains ASCII digits
+//
+// Parameters:
+//
+//   - s: the string to check
+//
+// Returns:
+//
+//   - bool: true if the string is not empty and only contains digits, false otherwise
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isUpperAlpha checks if a string only contains ASCII uppercase letters
+//
+// Parameters:
+//
+//   - s: the string to check
+//
+// Returns:
+//
+//   - bool: true if the string is not empty and only contains uppercase letters, false otherwise
+func isUpperAlpha(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// weightedSum returns the sum of the digits of a number multiplied by the weights
+//
+// Parameters:
+//
+//   - digits: the digits, which must be at least as many as the weights
+//   - weights: the weights of each digit
+//
+// Returns:
+//
+//   - int: the weighted sum
+func weightedSum(digits string, weights []int) int {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(digits[i]-'0') * weight
+	}
+	return sum
+}
+
+// allSameDigit checks if every digit of a number is the same, which are the placeholder numbers that pass most
+// checksums
+//
+// Parameters:
+//
+//   - digits: the digits
+//
+// Returns:
+//
+//   - bool: true if every digit is the same, false otherwise
+func allSameDigit(digits string) bool {
+	for i := 1; i < len(digits); i++ {
+		if digits[i] != digits[0] {
+			return false
+		}
+	}
+	return true
+}
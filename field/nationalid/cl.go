@@ -0,0 +1,43 @@
+package nationalid
+
+// ValidateRUT validates a Chilean RUT or RUN, which has up to 8 digits and a check digit or 'K', e.g. '12.345.678-5'
+//
+// Parameters:
+//
+//   - number: the normalized RUT
+//
+// Returns:
+//
+//   - error: the validation error, if the RUT is not valid
+func ValidateRUT(number string) error {
+	if len(number) < 2 || len(number) > 9 || !isDigits(number[:len(number)-1]) {
+		return ErrInvalidFormat
+	}
+	body, control := number[:len(number)-1], number[len(number)-1]
+	if control != 'K' && (control < '0' || control > '9') {
+		return ErrInvalidFormat
+	}
+
+	// Sum the digits from the right with the cycling weights from 2 to 7
+	sum := 0
+	weight := 2
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += int(body[i]-'0') * weight
+		if weight++; weight > 7 {
+			weight = 2
+		}
+	}
+	var checkDigit byte
+	switch remainder := 11 - sum%11; remainder {
+	case 11:
+		checkDigit = '0'
+	case 10:
+		checkDigit = 'K'
+	default:
+		checkDigit = byte('0' + remainder)
+	}
+	if control != checkDigit {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package nationalid
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+var (
+	ErrUnsupportedDocument = govalidatorfield.NewError(
+		"nationalid.unsupported_document",
+		"document type is not supported for the country",
+	)
+	ErrInvalidFormat = govalidatorfield.NewError(
+		"nationalid.invalid_format",
+		"document number format is not valid",
+	)
+	ErrInvalidChecksum = govalidatorfield.NewError(
+		"nationalid.invalid_checksum",
+		"document number check digit is not valid",
+	)
+	ErrInvalidDate = govalidatorfield.NewError(
+		"nationalid.invalid_date",
+		"document number date is not valid",
+	)
+	ErrInvalidDocument = govalidatorfield.NewError(
+		"nationalid.invalid_document",
+		"document number is not valid for any document type of the country",
+	)
+)
@@ -0,0 +1,104 @@
+package nationalid
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// dniLetters are the check letters of the DNI and NIE numbers, indexed by the number modulo 23
+	dniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+	// cifLetters are the check letters of the CIF codes, indexed by the check digit
+	cifLetters = "JABCDEFGHI"
+)
+
+// ValidateDNI validates a Spanish DNI, which has 8 digits and a check letter, e.g. '12345678Z'
+//
+// Parameters:
+//
+//   - number: the normalized DNI
+//
+// Returns:
+//
+//   - error: the validation error, if the DNI is not valid
+func ValidateDNI(number string) error {
+	if len(number) != 9 || !isDigits(number[:8]) || !isUpperAlpha(number[8:]) {
+		return ErrInvalidFormat
+	}
+	value, _ := strconv.Atoi(number[:8])
+	if dniLetters[value%23] != number[8] {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// ValidateNIE validates a Spanish NIE, which has a X, Y or Z prefix, 7 digits and a check letter, e.g. 'X1234567L'
+//
+// Parameters:
+//
+//   - number: the normalized NIE
+//
+// Returns:
+//
+//   - error: the validation error, if the NIE is not valid
+func ValidateNIE(number string) error {
+	if len(number) != 9 {
+		return ErrInvalidFormat
+	}
+
+	// The prefix is replaced by its index to get a DNI number
+	prefix := strings.IndexByte("XYZ", number[0])
+	if prefix < 0 {
+		return ErrInvalidFormat
+	}
+	return ValidateDNI(strconv.Itoa(prefix) + number[1:])
+}
+
+// ValidateCIF validates a Spanish CIF, which has an entity type letter, 7 digits and a check digit or letter, e.g.
+// 'A58818501'
+//
+// Parameters:
+//
+//   - number: the normalized CIF
+//
+// Returns:
+//
+//   - error: the validation error, if the CIF is not valid
+func ValidateCIF(number string) error {
+	if len(number) != 9 || !strings.ContainsRune("ABCDEFGHJNPQRSUVW", rune(number[0])) || !isDigits(number[1:8]) {
+		return ErrInvalidFormat
+	}
+
+	// Sum the digits in the even positions, and the digits of the doubled digits in the odd positions
+	sum := 0
+	for i := 1; i < 8; i++ {
+		digit := int(number[i] - '0')
+		if i%2 == 1 {
+			digit *= 2
+			digit = digit/10 + digit%10
+		}
+		sum += digit
+	}
+	checkDigit := (10 - sum%10) % 10
+
+	// Some entity types use a check letter, others a check digit, and the rest any of them
+	control := number[8]
+	isLetterControl := control == cifLetters[checkDigit]
+	isDigitControl := control == byte('0'+checkDigit)
+	switch {
+	case strings.ContainsRune("NPQRSW", rune(number[0])):
+		if !isLetterControl {
+			return ErrInvalidChecksum
+		}
+	case strings.ContainsRune("ABEH", rune(number[0])):
+		if !isDigitControl {
+			return ErrInvalidChecksum
+		}
+	default:
+		if !isLetterControl && !isDigitControl {
+			return ErrInvalidChecksum
+		}
+	}
+	return nil
+}
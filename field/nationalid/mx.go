@@ -0,0 +1,187 @@
+package nationalid
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// rfcAlphabet is the alphabet of the RFC check digit, where the space is used to pad the legal entities RFCs
+	rfcAlphabet = "0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ"
+
+	// curpAlphabet is the alphabet of the CURP check digit
+	curpAlphabet = "0123456789ABCDEFGHIJKLMNÑOPQRSTUVWXYZ"
+)
+
+var (
+	// curpStates are the codes of the Mexican states in the CURP, where 'NE' is used for the people born abroad
+	curpStates = map[string]struct{}{
+		"AS": {}, "BC": {}, "BS": {}, "CC": {}, "CL": {}, "CM": {}, "CS": {}, "CH": {}, "DF": {}, "DG": {}, "GT": {},
+		"GR": {}, "HG": {}, "JC": {}, "MC": {}, "MN": {}, "MS": {}, "NT": {}, "NL": {}, "OC": {}, "PL": {}, "QT": {},
+		"QR": {}, "SP": {}, "SL": {}, "SR": {}, "TC": {}, "TS": {}, "TL": {}, "VZ": {}, "YN": {}, "ZS": {}, "NE": {},
+	}
+
+	// rfcGenericNumbers are the generic RFCs of the public in general and the foreign residents
+	rfcGenericNumbers = map[string]struct{}{
+		"XAXX010101000": {},
+		"XEXX010101000": {},
+	}
+)
+
+// ValidateRFC validates a Mexican RFC, which has 4 letters for individuals or 3 for legal entities, the date of birth
+// or incorporation, a 2-character homoclave and a check digit, e.g. 'GODE561231GR8'
+//
+// Parameters:
+//
+//   - number: the normalized RFC
+//
+// Returns:
+//
+//   - error: the validation error, if the RFC is not valid
+func ValidateRFC(number string) error {
+	if _, ok := rfcGenericNumbers[number]; ok {
+		return nil
+	}
+
+	// Check the format of the RFC
+	runes := []rune(number)
+	if len(runes) != 12 && len(runes) != 13 {
+		return ErrInvalidFormat
+	}
+	nameLength := len(runes) - 9
+	for _, r := range runes[:nameLength] {
+		if !(r >= 'A' && r <= 'Z') && r != '&' && r != 'Ñ' {
+			return ErrInvalidFormat
+		}
+	}
+	date := string(runes[nameLength : nameLength+6])
+	homoclave := string(runes[nameLength+6:])
+	if !isDigits(date) || !isUpperAlphanumeric(homoclave) {
+		return ErrInvalidFormat
+	}
+	if !isValidDate(date) {
+		return ErrInvalidDate
+	}
+
+	// The legal entities RFCs are padded with a leading space to compute the check digit
+	if len(runes) == 12 {
+		runes = append([]rune{' '}, runes...)
+	}
+	sum := 0
+	alphabet := []rune(rfcAlphabet)
+	for i, r := range runes[:12] {
+		sum += runeIndex(alphabet, r) * (13 - i)
+	}
+	checkDigit := "0"
+	switch remainder := 11 - sum%11; remainder {
+	case 11:
+	case 10:
+		checkDigit = "A"
+	default:
+		checkDigit = string(rune('0' + remainder))
+	}
+	if string(runes[12]) != checkDigit {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// ValidateCURP validates a Mexican CURP, which has 4 letters, the date of birth, the sex, the state of birth, 3
+// consonants, a differentiator and a check digit, e.g. 'GODE561231HDFRRN00'
+//
+// Parameters:
+//
+//   - number: the normalized CURP
+//
+// Returns:
+//
+//   - error: the validation error, if the CURP is not valid
+func ValidateCURP(number string) error {
+	if len(number) != 18 ||
+		!isUpperAlpha(number[:4]) ||
+		!strings.ContainsRune("AEIOUX", rune(number[1])) ||
+		!isDigits(number[4:10]) ||
+		!strings.ContainsRune("HMX", rune(number[10])) ||
+		!isUpperAlpha(number[13:16]) ||
+		strings.ContainsAny(number[13:16], "AEIOU") ||
+		!isUpperAlphanumeric(number[16:17]) ||
+		!isDigits(number[17:]) {
+		return ErrInvalidFormat
+	}
+	if _, ok := curpStates[number[11:13]]; !ok {
+		return ErrInvalidFormat
+	}
+	if !isValidDate(number[4:10]) {
+		return ErrInvalidDate
+	}
+
+	// Sum the values of the first 17 characters, with weights from 18 to 2
+	sum := 0
+	alphabet := []rune(curpAlphabet)
+	for i := 0; i < 17; i++ {
+		sum += runeIndex(alphabet, rune(number[i])) * (18 - i)
+	}
+	if int(number[17]-'0') != (10-sum%10)%10 {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// isValidDate checks if a date in the YYMMDD format exists, in any century
+//
+// Parameters:
+//
+//   - date: the date digits
+//
+// Returns:
+//
+//   - bool: true if the date exists, false otherwise
+func isValidDate(date string) bool {
+	// Both centuries are checked, so February 29 of the year 00 is accepted since 2000 is a leap year
+	_, err := time.Parse("20060102", "20"+date)
+	if err == nil {
+		return true
+	}
+	_, err = time.Parse("20060102", "19"+date)
+	return err == nil
+}
+
+// isUpperAlphanumeric checks if a string only contains ASCII uppercase letters and digits
+//
+// Parameters:
+//
+//   - s: the string to check
+//
+// Returns:
+//
+//   - bool: true if the string is not empty and only contains uppercase letters and digits, false otherwise
+func isUpperAlphanumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isUpperAlpha(s[i:i+1]) && !isDigits(s[i:i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// runeIndex returns the index of a rune in an alphabet
+//
+// Parameters:
+//
+//   - alphabet: the alphabet
+//   - r: the rune
+//
+// Returns:
+//
+//   - int: the index of the rune, -1 if it is not in the alphabet
+func runeIndex(alphabet []rune, r rune) int {
+	for i, alphabetRune := range alphabet {
+		if alphabetRune == r {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,261 @@
+package nationalid
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+type (
+	// Registry is the registry of the document validators, keyed by country and document type
+	Registry struct {
+		mutex      sync.RWMutex
+		validators map[string]map[DocumentType]ValidateFn
+	}
+)
+
+var (
+	// defaultRegistry is the registry with the bundled document validators
+	defaultRegistry     *Registry
+	defaultRegistryOnce sync.Once
+)
+
+// NewRegistry creates a new empty registry
+//
+// Returns:
+//
+//   - *Registry: the registry
+func NewRegistry() *Registry {
+	return &Registry{
+		validators: make(map[string]map[DocumentType]ValidateFn),
+	}
+}
+
+// DefaultRegistry returns the registry with the bundled document validators, which are ES DNI/NIE/CIF, VE RIF,
+// MX RFC/CURP, BR CPF/CNPJ, AR CUIT and CL RUT
+//
+// Returns:
+//
+//   - *Registry: the registry
+func DefaultRegistry() *Registry {
+	defaultRegistryOnce.Do(
+		func() {
+			defaultRegistry = NewRegistry()
+			defaultRegistry.Register("ES", DocumentDNI, ValidateDNI)
+			defaultRegistry.Register("ES", DocumentNIE, ValidateNIE)
+			defaultRegistry.Register("ES", DocumentCIF, ValidateCIF)
+			defaultRegistry.Register("VE", DocumentRIF, ValidateRIF)
+			defaultRegistry.Register("MX", DocumentRFC, ValidateRFC)
+			defaultRegistry.Register("MX", DocumentCURP, ValidateCURP)
+			defaultRegistry.Register("BR", DocumentCPF, ValidateCPF)
+			defaultRegistry.Register("BR", DocumentCNPJ, ValidateCNPJ)
+			defaultRegistry.Register("AR", DocumentCUIT, ValidateCUIT)
+			defaultRegistry.Register("CL", DocumentRUT, ValidateRUT)
+		},
+	)
+	return defaultRegistry
+}
+
+// Register registers the validator of a document type of a country, replacing the previous one
+//
+// Parameters:
+//
+//   - country: the ISO 3166-1 alpha-2 country code
+//   - documentType: the document type
+//   - validateFn: the validator of the normalized document numbers
+func (r *Registry) Register(country string, documentType DocumentType, validateFn ValidateFn) {
+	if r == nil || validateFn == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Initialize the validators map if it is nil
+	if r.validators == nil {
+		r.validators = make(map[string]map[DocumentType]ValidateFn)
+	}
+
+	country = normalizeCountry(country)
+	if r.validators[country] == nil {
+		r.validators[country] = make(map[DocumentType]ValidateFn)
+	}
+	r.validators[country][normalizeDocumentType(documentType)] = validateFn
+}
+
+// Lookup returns the validator of a document type of a country
+//
+// Parameters:
+//
+//   - country: the ISO 3166-1 alpha-2 country code, case-insensitive
+//   - documentType: the document type, case-insensitive
+//
+// Returns:
+//
+//   - ValidateFn: the validator
+//   - bool: true if the document type is registered for the country, false otherwise
+func (r *Registry) Lookup(country string, documentType DocumentType) (ValidateFn, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	validateFn, ok := r.validators[normalizeCountry(country)][normalizeDocumentType(documentType)]
+	return validateFn, ok
+}
+
+// DocumentTypes returns the sorted document types registered for a country
+//
+// Parameters:
+//
+//   - country: the ISO 3166-1 alpha-2 country code, case-insensitive
+//
+// Returns:
+//
+//   - []DocumentType: the document types
+func (r *Registry) DocumentTypes(country string) []DocumentType {
+	if r == nil {
+		return nil
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	documentTypes := make([]DocumentType, 0, len(r.validators[normalizeCountry(country)]))
+	for documentType := range r.validators[normalizeCountry(country)] {
+		documentTypes = append(documentTypes, documentType)
+	}
+	sort.Slice(
+		documentTypes, func(i, j int) bool {
+			return documentTypes[i] < documentTypes[j]
+		},
+	)
+	return documentTypes
+}
+
+// Validate validates a document number of a country. If the document type is empty, the number is valid if any of the
+// document types of the country accepts it
+//
+// Parameters:
+//
+//   - country: the ISO 3166-1 alpha-2 country code, e.g. taken from a sibling field
+//   - documentType: the document type (optional, can be empty)
+//   - number: the document number to validate, it may contain dots, hyphens, slashes and spaces
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the document number is valid
+func (r *Registry) Validate(country string, documentType DocumentType, number string) []error {
+	country = normalizeCountry(country)
+	number = Normalize(number)
+
+	// Check the document number against every document type of the country
+	if documentType == "" {
+		documentTypes := r.DocumentTypes(country)
+		if len(documentTypes) == 0 {
+			return []error{withMetadata(ErrUnsupportedDocument, country, "")}
+		}
+		for _, documentType = range documentTypes {
+			if validateFn, ok := r.Lookup(country, documentType); ok && validateFn(number) == nil {
+				return nil
+			}
+		}
+		return []error{withMetadata(ErrInvalidDocument, country, "")}
+	}
+
+	validateFn, ok := r.Lookup(country, documentType)
+	if !ok {
+		return []error{withMetadata(ErrUnsupportedDocument, country, normalizeDocumentType(documentType))}
+	}
+	if err := validateFn(number); err != nil {
+		return []error{withMetadata(err, country, normalizeDocumentType(documentType))}
+	}
+	return nil
+}
+
+// Validate validates a document number of a country with the default registry
+//
+// Parameters:
+//
+//   - country: the ISO 3166-1 alpha-2 country code, e.g. taken from a sibling field
+//   - documentType: the document type (optional, if empty any document type of the country is accepted)
+//   - number: the document number to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the document number is valid
+func Validate(country string, documentType DocumentType, number string) []error {
+	return DefaultRegistry().Validate(country, documentType, number)
+}
+
+// Normalize removes the dots, hyphens, slashes and spaces of a document number and converts it to uppercase
+//
+// Parameters:
+//
+//   - number: the document number
+//
+// Returns:
+//
+//   - string: the normalized document number
+func Normalize(number string) string {
+	return strings.ToUpper(
+		strings.Map(
+			func(r rune) rune {
+				if strings.ContainsRune(".-/ ", r) {
+					return -1
+				}
+				return r
+			}, number,
+		),
+	)
+}
+
+// normalizeCountry normalizes a country code to uppercase without surrounding spaces
+//
+// Parameters:
+//
+//   - country: the country code
+//
+// Returns:
+//
+//   - string: the normalized country code
+func normalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+// normalizeDocumentType normalizes a document type to lowercase without surrounding spaces
+//
+// Parameters:
+//
+//   - documentType: the document type
+//
+// Returns:
+//
+//   - DocumentType: the normalized document type
+func normalizeDocumentType(documentType DocumentType) DocumentType {
+	return DocumentType(strings.ToLower(strings.TrimSpace(string(documentType))))
+}
+
+// withMetadata attaches the country and the document type to a coded validation error
+//
+// Parameters:
+//
+//   - err: the validation error
+//   - country: the country code
+//   - documentType: the document type (optional, can be empty)
+//
+// Returns:
+//
+//   - error: the validation error with the metadata
+func withMetadata(err error, country string, documentType DocumentType) error {
+	fieldErr, ok := err.(*govalidatorfield.Error)
+	if !ok {
+		return err
+	}
+	fieldErr = fieldErr.WithMetadata(CountryMetadataKey, country)
+	if documentType != "" {
+		fieldErr = fieldErr.WithMetadata(DocumentTypeMetadataKey, string(documentType))
+	}
+	return fieldErr
+}
@@ -0,0 +1,49 @@
+package nationalid
+
+type (
+	// DocumentType is the type of a national identity or tax document
+	DocumentType string
+
+	// ValidateFn validates the normalized number of a document
+	ValidateFn func(number string) error
+)
+
+const (
+	// CountryMetadataKey is the metadata key of the country in the validation errors
+	CountryMetadataKey = "country"
+
+	// DocumentTypeMetadataKey is the metadata key of the document type in the validation errors
+	DocumentTypeMetadataKey = "document_type"
+)
+
+const (
+	// DocumentDNI is the Spanish national identity document
+	DocumentDNI DocumentType = "dni"
+
+	// DocumentNIE is the Spanish foreigner identity number
+	DocumentNIE DocumentType = "nie"
+
+	// DocumentCIF is the Spanish legal entity tax code
+	DocumentCIF DocumentType = "cif"
+
+	// DocumentRIF is the Venezuelan tax information registry number
+	DocumentRIF DocumentType = "rif"
+
+	// DocumentRFC is the Mexican federal taxpayer registry number
+	DocumentRFC DocumentType = "rfc"
+
+	// DocumentCURP is the Mexican unique population registry code
+	DocumentCURP DocumentType = "curp"
+
+	// DocumentCPF is the Brazilian individual taxpayer registry number
+	DocumentCPF DocumentType = "cpf"
+
+	// DocumentCNPJ is the Brazilian legal entity registry number
+	DocumentCNPJ DocumentType = "cnpj"
+
+	// DocumentCUIT is the Argentine unique tax identification code
+	DocumentCUIT DocumentType = "cuit"
+
+	// DocumentRUT is the Chilean unique tax role number
+	DocumentRUT DocumentType = "rut"
+)
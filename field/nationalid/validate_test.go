@@ -0,0 +1,207 @@
+package nationalid
+
+import (
+	"errors"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestValidateFns(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		validateFn ValidateFn
+		number     string
+		want       error
+	}{
+		{name: "DNI", validateFn: ValidateDNI, number: "12345678Z"},
+		{name: "DNI off by one", validateFn: ValidateDNI, number: "12345678A", want: ErrInvalidChecksum},
+		{name: "DNI invalid format", validateFn: ValidateDNI, number: "1234567Z", want: ErrInvalidFormat},
+		{name: "NIE", validateFn: ValidateNIE, number: "X1234567L"},
+		{name: "NIE Y prefix", validateFn: ValidateNIE, number: "Y0000000Z"},
+		{name: "NIE off by one", validateFn: ValidateNIE, number: "X1234567M", want: ErrInvalidChecksum},
+		{name: "NIE invalid prefix", validateFn: ValidateNIE, number: "A1234567L", want: ErrInvalidFormat},
+		{name: "CIF digit control", validateFn: ValidateCIF, number: "A58818501"},
+		{name: "CIF digit control off by one", validateFn: ValidateCIF, number: "A58818502", want: ErrInvalidChecksum},
+		{name: "CIF letter for digit type", validateFn: ValidateCIF, number: "A5881850A", want: ErrInvalidChecksum},
+		{name: "CIF letter control", validateFn: ValidateCIF, number: "Q2826000H"},
+		{name: "CIF letter control off by one", validateFn: ValidateCIF, number: "Q2826000I", want: ErrInvalidChecksum},
+		{name: "CIF digit for letter type", validateFn: ValidateCIF, number: "Q28260008", want: ErrInvalidChecksum},
+		{name: "CIF either control letter", validateFn: ValidateCIF, number: "G1234567D"},
+		{name: "CIF either control digit", validateFn: ValidateCIF, number: "G12345674"},
+		{name: "CIF invalid entity type", validateFn: ValidateCIF, number: "I58818501", want: ErrInvalidFormat},
+		{name: "RIF", validateFn: ValidateRIF, number: "J001241345"},
+		{name: "RIF natural person", validateFn: ValidateRIF, number: "V123456781"},
+		{name: "RIF off by one", validateFn: ValidateRIF, number: "J001241346", want: ErrInvalidChecksum},
+		{name: "RIF invalid type", validateFn: ValidateRIF, number: "X001241345", want: ErrInvalidFormat},
+		{name: "RFC individual", validateFn: ValidateRFC, number: "GODE561231GR8"},
+		{name: "RFC individual off by one", validateFn: ValidateRFC, number: "GODE561231GR9", want: ErrInvalidChecksum},
+		{name: "RFC legal entity", validateFn: ValidateRFC, number: "MAB9307148T4"},
+		{
+			name:       "RFC legal entity off by one",
+			validateFn: ValidateRFC,
+			number:     "MAB9307148T5",
+			want:       ErrInvalidChecksum,
+		},
+		{name: "RFC generic", validateFn: ValidateRFC, number: "XAXX010101000"},
+		{name: "RFC invalid date", validateFn: ValidateRFC, number: "GODE561331GR8", want: ErrInvalidDate},
+		{name: "RFC invalid format", validateFn: ValidateRFC, number: "GODE56123GR8", want: ErrInvalidFormat},
+		{name: "CURP", validateFn: ValidateCURP, number: "BOXW310820HNERXN09"},
+		{name: "CURP off by one", validateFn: ValidateCURP, number: "BOXW310820HNERXN00", want: ErrInvalidChecksum},
+		{name: "CURP invalid state", validateFn: ValidateCURP, number: "BOXW310820HXXRXN09", want: ErrInvalidFormat},
+		{name: "CURP invalid date", validateFn: ValidateCURP, number: "BOXW310230HNERXN09", want: ErrInvalidDate},
+		{name: "CPF", validateFn: ValidateCPF, number: "52998224725"},
+		{
+			name:       "CPF first digit off by one",
+			validateFn: ValidateCPF,
+			number:     "52998224735",
+			want:       ErrInvalidChecksum,
+		},
+		{name: "CPF off by one", validateFn: ValidateCPF, number: "52998224726", want: ErrInvalidChecksum},
+		{name: "CPF same digits", validateFn: ValidateCPF, number: "11111111111", want: ErrInvalidChecksum},
+		{name: "CNPJ", validateFn: ValidateCNPJ, number: "11222333000181"},
+		{name: "CNPJ off by one", validateFn: ValidateCNPJ, number: "11222333000182", want: ErrInvalidChecksum},
+		{name: "CNPJ alphanumeric", validateFn: ValidateCNPJ, number: "12ABC34501DE35"},
+		{
+			name:       "CNPJ alphanumeric off by one",
+			validateFn: ValidateCNPJ,
+			number:     "12ABC34501DE36",
+			want:       ErrInvalidChecksum,
+		},
+		{name: "CNPJ same digits", validateFn: ValidateCNPJ, number: "00000000000000", want: ErrInvalidChecksum},
+		{name: "CUIT", validateFn: ValidateCUIT, number: "20123456786"},
+		{name: "CUIT legal entity", validateFn: ValidateCUIT, number: "30500010912"},
+		{name: "CUIT off by one", validateFn: ValidateCUIT, number: "20123456787", want: ErrInvalidChecksum},
+		{name: "CUIT invalid prefix", validateFn: ValidateCUIT, number: "21123456786", want: ErrInvalidFormat},
+		{name: "RUT", validateFn: ValidateRUT, number: "123456785"},
+		{name: "RUT K control", validateFn: ValidateRUT, number: "10000013K"},
+		{name: "RUT off by one", validateFn: ValidateRUT, number: "123456786", want: ErrInvalidChecksum},
+		{name: "RUT K off by one", validateFn: ValidateRUT, number: "100000130", want: ErrInvalidChecksum},
+		{name: "RUT invalid control", validateFn: ValidateRUT, number: "12345678X", want: ErrInvalidFormat},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if err := tt.validateFn(tt.number); !errors.Is(err, tt.want) {
+					t.Errorf("validate(%q) = %v, want %v", tt.number, err, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		number string
+		want   string
+	}{
+		{number: "529.982.247-25", want: "52998224725"},
+		{number: "11.222.333/0001-81", want: "11222333000181"},
+		{number: "j-00124134-5", want: "J001241345"},
+		{number: "12 345 678 z", want: "12345678Z"},
+	} {
+		if got := Normalize(tt.number); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.number, got, tt.want)
+		}
+	}
+}
+
+func TestRegistryLookup(t *testing.T) {
+	for _, tt := range []struct {
+		country      string
+		documentType DocumentType
+		ok           bool
+	}{
+		{country: "ES", documentType: DocumentDNI, ok: true},
+		{country: " es ", documentType: "NIE", ok: true},
+		{country: "VE", documentType: DocumentRIF, ok: true},
+		{country: "MX", documentType: DocumentCURP, ok: true},
+		{country: "BR", documentType: DocumentCNPJ, ok: true},
+		{country: "AR", documentType: DocumentCUIT, ok: true},
+		{country: "CL", documentType: DocumentRUT, ok: true},
+		{country: "ES", documentType: DocumentRUT},
+		{country: "US", documentType: DocumentDNI},
+	} {
+		if _, ok := DefaultRegistry().Lookup(tt.country, tt.documentType); ok != tt.ok {
+			t.Errorf("Lookup(%q, %q) = %v, want %v", tt.country, tt.documentType, ok, tt.ok)
+		}
+	}
+
+	want := []DocumentType{DocumentCIF, DocumentDNI, DocumentNIE}
+	got := DefaultRegistry().DocumentTypes("es")
+	if len(got) != len(want) {
+		t.Fatalf("DocumentTypes(es) = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("DocumentTypes(es) = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		country      string
+		documentType DocumentType
+		number       string
+		want         error
+		metadata     map[string]string
+	}{
+		{name: "valid", country: "BR", documentType: DocumentCPF, number: "529.982.247-25"},
+		{name: "any document type", country: "ES", number: "X1234567L"},
+		{
+			name:         "invalid checksum",
+			country:      "cl",
+			documentType: DocumentRUT,
+			number:       "12.345.678-6",
+			want:         ErrInvalidChecksum,
+			metadata:     map[string]string{CountryMetadataKey: "CL", DocumentTypeMetadataKey: "rut"},
+		},
+		{
+			name:     "invalid for any document type",
+			country:  "ES",
+			number:   "12345678A",
+			want:     ErrInvalidDocument,
+			metadata: map[string]string{CountryMetadataKey: "ES"},
+		},
+		{
+			name:         "unsupported document type",
+			country:      "AR",
+			documentType: DocumentCPF,
+			number:       "529.982.247-25",
+			want:         ErrUnsupportedDocument,
+			metadata:     map[string]string{CountryMetadataKey: "AR", DocumentTypeMetadataKey: "cpf"},
+		},
+		{
+			name:     "unsupported country",
+			country:  "US",
+			number:   "123-45-6789",
+			want:     ErrUnsupportedDocument,
+			metadata: map[string]string{CountryMetadataKey: "US"},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := Validate(tt.country, tt.documentType, tt.number)
+				if tt.want == nil {
+					if len(errs) != 0 {
+						t.Fatalf("Validate(%q) = %v, want nil", tt.number, errs)
+					}
+					return
+				}
+				if len(errs) != 1 || !errors.Is(errs[0], tt.want) {
+					t.Fatalf("Validate(%q) = %v, want %v", tt.number, errs, tt.want)
+				}
+				metadata := govalidatorfield.GetMetadata(errs[0])
+				if len(metadata) != len(tt.metadata) {
+					t.Fatalf("Validate(%q) metadata = %v, want %v", tt.number, metadata, tt.metadata)
+				}
+				for key, value := range tt.metadata {
+					if metadata[key] != value {
+						t.Errorf("Validate(%q) metadata = %v, want %v", tt.number, metadata, tt.metadata)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,40 @@
+package nationalid
+
+var (
+	// rifWeights are the weights of the RIF type value and its 8 digits
+	rifWeights = []int{3, 2, 7, 6, 5, 4, 3, 2}
+
+	// rifTypeValues are the values of the RIF person types
+	rifTypeValues = map[byte]int{'V': 1, 'E': 2, 'J': 3, 'C': 3, 'P': 4, 'G': 5}
+)
+
+// ValidateRIF validates a Venezuelan RIF, which has a person type letter, 8 digits and a check digit, e.g.
+// 'J-00124134-5'
+//
+// Parameters:
+//
+//   - number: the normalized RIF
+//
+// Returns:
+//
+//   - error: the validation error, if the RIF is not valid
+func ValidateRIF(number string) error {
+	if len(number) != 10 || !isDigits(number[1:]) {
+		return ErrInvalidFormat
+	}
+	typeValue, ok := rifTypeValues[number[0]]
+	if !ok {
+		return ErrInvalidFormat
+	}
+
+	// The type value has a weight of 4, followed by the weights of the digits
+	sum := typeValue*4 + weightedSum(number[1:9], rifWeights)
+	checkDigit := 11 - sum%11
+	if checkDigit >= 10 {
+		checkDigit = 0
+	}
+	if int(number[9]-'0') != checkDigit {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
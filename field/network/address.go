@@ -0,0 +1,151 @@
+package network
+
+import (
+	"net/netip"
+)
+
+var (
+	// nat64Prefix is the NAT64 well-known prefix, whose addresses embed an IPv4 address, as defined by RFC 6052
+	nat64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+
+	// sixToFourPrefix is the 6to4 prefix, whose addresses embed an IPv4 address after the prefix, as defined by RFC 3056
+	sixToFourPrefix = netip.MustParsePrefix("2002::/16")
+
+	// ipv4CompatiblePrefix is the deprecated IPv4-compatible prefix, whose addresses embed an IPv4 address, as defined
+	// by RFC 4291
+	ipv4CompatiblePrefix = netip.MustParsePrefix("::/96")
+
+	// reservedPrefixes are the special-purpose prefixes that are not reported by the netip.Addr methods, as defined
+	// by RFC 6890 and its updates
+	reservedPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+		netip.MustParsePrefix("192.0.0.0/24"),
+		netip.MustParsePrefix("192.0.2.0/24"),
+		netip.MustParsePrefix("198.18.0.0/15"),
+		netip.MustParsePrefix("198.51.100.0/24"),
+		netip.MustParsePrefix("203.0.113.0/24"),
+		netip.MustParsePrefix("240.0.0.0/4"),
+		netip.MustParsePrefix("64:ff9b:1::/48"),
+		netip.MustParsePrefix("100::/64"),
+		netip.MustParsePrefix("2001::/23"),
+		netip.MustParsePrefix("2001:db8::/32"),
+	}
+)
+
+// IsPublicAddress checks if an IP address is globally routable, so it is not a private, loopback, link-local,
+// multicast, unspecified or reserved address. IPv4-mapped, IPv4-compatible, NAT64 and 6to4 IPv6 addresses are checked
+// by their embedded IPv4 address
+//
+// Parameters:
+//
+//   - addr: the IP address to check
+//
+// Returns:
+//
+//   - bool: true if the address is public, false otherwise
+func IsPublicAddress(addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	addr = addr.Unmap()
+
+	// Check the IPv4 address embedded in the NAT64 well-known prefix or the IPv4-compatible prefix
+	if nat64Prefix.Contains(addr) || ipv4CompatiblePrefix.Contains(addr) {
+		bytes := addr.As16()
+		return IsPublicAddress(netip.AddrFrom4([4]byte(bytes[12:])))
+	}
+
+	// Check the IPv4 address embedded in the 6to4 prefix
+	if sixToFourPrefix.Contains(addr) {
+		bytes := addr.As16()
+		return IsPublicAddress(netip.AddrFrom4([4]byte(bytes[2:6])))
+	}
+
+	if addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateIP validates an IPv4 or IPv6 address, without zones
+//
+// Parameters:
+//
+//   - ip: the IP address to validate
+//   - family: the allowed IP address families, if zero every family is allowed
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the IP address is valid
+func ValidateIP(ip string, family Family) []error {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || addr.Zone() != "" {
+		return []error{ErrInvalidIP}
+	}
+	if !isAllowedFamily(addr, family) {
+		return []error{ErrIPFamilyNotAllowed}
+	}
+	return nil
+}
+
+// ValidateCIDR validates a CIDR prefix, e.g. '10.0.0.0/8' or '2001:db8::/32'
+//
+// Parameters:
+//
+//   - cidr: the CIDR prefix to validate
+//   - options: the CIDR prefix validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the CIDR prefix is valid
+func ValidateCIDR(cidr string, options *CIDROptions) []error {
+	if options == nil {
+		options = &CIDROptions{}
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return []error{ErrInvalidCIDR}
+	}
+
+	var errs []error
+	if !isAllowedFamily(prefix.Addr(), options.Family) {
+		errs = append(errs, ErrIPFamilyNotAllowed)
+	}
+	if options.RequireNetworkAddress && prefix.Masked() != prefix {
+		errs = append(errs, ErrCIDRNotNetworkAddress)
+	}
+	return errs
+}
+
+// isAllowedFamily checks if the family of an IP address is allowed, IPv4-mapped IPv6 addresses are IPv6 addresses
+//
+// Parameters:
+//
+//   - addr: the IP address
+//   - family: the allowed IP address families, if zero every family is allowed
+//
+// Returns:
+//
+//   - bool: true if the family is allowed, false otherwise
+func isAllowedFamily(addr netip.Addr, family Family) bool {
+	if family == 0 {
+		return true
+	}
+	if addr.Is4() {
+		return family.Has(FamilyIPv4)
+	}
+	return family.Has(FamilyIPv6)
+}
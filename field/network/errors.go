@@ -0,0 +1,80 @@
+package network
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+var (
+	ErrInvalidURL = govalidatorfield.NewError(
+		"network.invalid_url",
+		"invalid URL",
+	)
+	ErrURLNotAbsolute = govalidatorfield.NewError(
+		"network.url_not_absolute",
+		"URL must be absolute",
+	)
+	ErrURLSchemeNotAllowed = govalidatorfield.NewError(
+		"network.url_scheme_not_allowed",
+		"URL scheme is not allowed",
+	)
+	ErrURLMissingHost = govalidatorfield.NewError(
+		"network.url_missing_host",
+		"URL must have a host",
+	)
+	ErrURLUserInfoNotAllowed = govalidatorfield.NewError(
+		"network.url_user_info_not_allowed",
+		"URL must not contain credentials",
+	)
+	ErrURLInvalidHost = govalidatorfield.NewError(
+		"network.url_invalid_host",
+		"URL host is not a valid hostname or IP address",
+	)
+	ErrURLInvalidPort = govalidatorfield.NewError(
+		"network.url_invalid_port",
+		"URL port must be between 1 and 65535",
+	)
+	ErrURLPrivateAddress = govalidatorfield.NewError(
+		"network.url_private_address",
+		"URL must not point to a private, loopback or reserved address",
+	)
+	ErrURLUnresolvableHost = govalidatorfield.NewError(
+		"network.url_unresolvable_host",
+		"URL host could not be resolved",
+	)
+	ErrInvalidHostname = govalidatorfield.NewError(
+		"network.invalid_hostname",
+		"hostname must only contain letters, digits and hyphens, and its labels must not start or end with a hyphen",
+	)
+	ErrHostnameTooLong = govalidatorfield.NewError(
+		"network.hostname_too_long",
+		"hostname must not be longer than 253 characters",
+	)
+	ErrHostnameLabelTooLong = govalidatorfield.NewError(
+		"network.hostname_label_too_long",
+		"hostname labels must not be longer than 63 characters",
+	)
+	ErrNumericTopLevelDomain = govalidatorfield.NewError(
+		"network.numeric_top_level_domain",
+		"hostname top-level domain must not be numeric",
+	)
+	ErrInvalidIP = govalidatorfield.NewError(
+		"network.invalid_ip",
+		"invalid IP address",
+	)
+	ErrIPFamilyNotAllowed = govalidatorfield.NewError(
+		"network.ip_family_not_allowed",
+		"IP address family is not allowed",
+	)
+	ErrInvalidCIDR = govalidatorfield.NewError(
+		"network.invalid_cidr",
+		"invalid CIDR prefix",
+	)
+	ErrCIDRNotNetworkAddress = govalidatorfield.NewError(
+		"network.cidr_not_network_address",
+		"CIDR prefix must not have host bits set",
+	)
+	ErrInvalidPort = govalidatorfield.NewError(
+		"network.invalid_port",
+		"port must be a number between 1 and 65535",
+	)
+)
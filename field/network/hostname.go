@@ -0,0 +1,122 @@
+package network
+
+import (
+	"strings"
+)
+
+// ValidateHostname validates a hostname as defined by RFC 1123, a single trailing dot is allowed. Numeric top-level
+// domains, either decimal or hexadecimal, are rejected, so the decimal, octal, hexadecimal and short IPv4 forms such as
+// '2130706433', '0177.0.0.1', '0x7f000001' or '127.1', which URL parsers resolve to an IPv4 address, are not taken as
+// hostnames
+//
+// Parameters:
+//
+//   - hostname: the hostname to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the hostname is valid
+func ValidateHostname(hostname string) []error {
+	hostname = strings.TrimSuffix(hostname, ".")
+	if hostname == "" {
+		return []error{ErrInvalidHostname}
+	}
+
+	var errs []error
+
+	// Check the length of the hostname
+	if len(hostname) > MaximumHostnameLength {
+		errs = append(errs, ErrHostnameTooLong)
+	}
+
+	// Check the labels of the hostname
+	var hasInvalidLabel, hasLongLabel bool
+	labels := strings.Split(hostname, ".")
+	for _, label := range labels {
+		if len(label) > MaximumHostnameLabelLength {
+			hasLongLabel = true
+		}
+		if !isHostnameLabel(label) {
+			hasInvalidLabel = true
+		}
+	}
+	if hasLongLabel {
+		errs = append(errs, ErrHostnameLabelTooLong)
+	}
+	if hasInvalidLabel {
+		errs = append(errs, ErrInvalidHostname)
+	} else if isIPv4Number(labels[len(labels)-1]) {
+		errs = append(errs, ErrNumericTopLevelDomain)
+	}
+	return errs
+}
+
+// isHostnameLabel checks if a label only contains letters, digits and hyphens, and does not start or end with a
+// hyphen
+//
+// Parameters:
+//
+//   - label: the label to check
+//
+// Returns:
+//
+//   - bool: true if the label is valid, false otherwise
+func isHostnameLabel(label string) bool {
+	if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// isIPv4Number checks if a label is a number in any of the forms accepted by the URL parsers for the parts of an IPv4
+// address, either decimal, octal with a leading zero, or hexadecimal with a '0x' prefix
+//
+// Parameters:
+//
+//   - label: the label to check
+//
+// Returns:
+//
+//   - bool: true if the label is a number, false otherwise
+func isIPv4Number(label string) bool {
+	if isNumeric(label) {
+		return true
+	}
+	if len(label) < 2 || label[0] != '0' || (label[1] != 'x' && label[1] != 'X') {
+		return false
+	}
+	for i := 2; i < len(label); i++ {
+		c := label[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumeric checks if a string only contains ASCII digits
+//
+// Parameters:
+//
+//   - s: the string to check
+//
+// Returns:
+//
+//   - bool: true if the string is not empty and only contains digits, false otherwise
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,13 @@
+package network
+
+import (
+	"context"
+	"net/netip"
+)
+
+type (
+	// Resolver is an interface to resolve the IP addresses of a hostname, it is implemented by net.Resolver
+	Resolver interface {
+		LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+	}
+)
@@ -0,0 +1,42 @@
+package network
+
+import (
+	"strconv"
+)
+
+// ValidatePort validates a port number
+//
+// Parameters:
+//
+//   - port: the port to validate
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the port is between 1 and 65535
+func ValidatePort(port int) []error {
+	if port < 1 || port > MaximumPort {
+		return []error{ErrInvalidPort}
+	}
+	return nil
+}
+
+// ParsePort strictly parses a port number, without signs or surrounding spaces
+//
+// Parameters:
+//
+//   - port: the port to parse
+//
+// Returns:
+//
+//   - int: the port number
+//   - error: the validation error, if the port is not a number between 1 and 65535
+func ParsePort(port string) (int, error) {
+	if !isNumeric(port) || len(port) > len(strconv.Itoa(MaximumPort)) {
+		return 0, ErrInvalidPort
+	}
+	parsedPort, _ := strconv.Atoi(port)
+	if errs := ValidatePort(parsedPort); len(errs) > 0 {
+		return 0, errs[0]
+	}
+	return parsedPort, nil
+}
@@ -0,0 +1,161 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+// ValidateURL validates an absolute URL
+//
+// Parameters:
+//
+//   - rawURL: the URL to validate
+//   - options: the URL validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the URL is valid
+func ValidateURL(rawURL string, options *URLOptions) []error {
+	_, errs := validateURL(rawURL, options)
+	return errs
+}
+
+// ValidateURLWithContext validates an absolute URL and, if the other validations passed and the options deny the
+// private addresses, resolves its hostname to check that every address is public. The resolved addresses may change
+// before the URL is requested, so the HTTP client dialer should check them again to prevent DNS rebinding
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - rawURL: the URL to validate
+//   - options: the URL validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the URL is valid
+//   - error: if the context was canceled or the hostname lookup failed for reasons other than the host not existing
+func ValidateURLWithContext(ctx context.Context, rawURL string, options *URLOptions) ([]error, error) {
+	parsedURL, errs := validateURL(rawURL, options)
+	if len(errs) > 0 || options == nil || !options.DenyPrivateAddresses || options.Resolver == nil {
+		return errs, nil
+	}
+
+	// IP literals were already checked
+	hostname := parsedURL.Hostname()
+	if _, err := netip.ParseAddr(hostname); err == nil {
+		return nil, nil
+	}
+
+	// Resolve the hostname and check its addresses
+	addrs, err := options.Resolver.LookupNetIP(ctx, "ip", hostname)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return []error{ErrURLUnresolvableHost}, nil
+		}
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return []error{ErrURLUnresolvableHost}, nil
+	}
+	for _, addr := range addrs {
+		if !IsPublicAddress(addr) {
+			return []error{ErrURLPrivateAddress}, nil
+		}
+	}
+	return nil, nil
+}
+
+// validateURL parses and validates an absolute URL
+//
+// Parameters:
+//
+//   - rawURL: the URL to validate
+//   - options: the URL validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - *url.URL: the parsed URL, nil if it could not be parsed
+//   - []error: the validation errors, nil if the URL is valid
+func validateURL(rawURL string, options *URLOptions) (*url.URL, []error) {
+	if options == nil {
+		options = &URLOptions{}
+	}
+
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, []error{ErrInvalidURL}
+	}
+	if !parsedURL.IsAbs() {
+		return nil, []error{ErrURLNotAbsolute}
+	}
+
+	var errs []error
+
+	// Check if the scheme is allowed
+	allowedSchemes := options.AllowedSchemes
+	if len(allowedSchemes) == 0 {
+		allowedSchemes = DefaultAllowedSchemes
+	}
+	isAllowedScheme := false
+	for _, scheme := range allowedSchemes {
+		if strings.EqualFold(scheme, parsedURL.Scheme) {
+			isAllowedScheme = true
+			break
+		}
+	}
+	if !isAllowedScheme {
+		errs = append(errs, ErrURLSchemeNotAllowed)
+	}
+
+	// Check if the URL has credentials
+	if parsedURL.User != nil && !options.AllowUserInfo {
+		errs = append(errs, ErrURLUserInfoNotAllowed)
+	}
+
+	// Check the host of the URL
+	hostname := parsedURL.Hostname()
+	if hostname == "" {
+		return nil, append(errs, ErrURLMissingHost)
+	}
+	if port := parsedURL.Port(); port != "" {
+		if _, err = ParsePort(port); err != nil {
+			errs = append(errs, ErrURLInvalidPort)
+		}
+	}
+	if addr, err := netip.ParseAddr(hostname); err == nil {
+		if options.DenyPrivateAddresses && !IsPublicAddress(addr) {
+			errs = append(errs, ErrURLPrivateAddress)
+		}
+	} else if len(ValidateHostname(hostname)) > 0 {
+		errs = append(errs, ErrURLInvalidHost)
+	} else if options.DenyPrivateAddresses && isLocalhost(hostname) {
+		errs = append(errs, ErrURLPrivateAddress)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return parsedURL, nil
+}
+
+// isLocalhost checks if a hostname is a localhost name, as defined by RFC 6761
+//
+// Parameters:
+//
+//   - hostname: the hostname to check
+//
+// Returns:
+//
+//   - bool: true if the hostname is localhost or a subdomain of it, false otherwise
+func isLocalhost(hostname string) bool {
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	return hostname == "localhost" || strings.HasSuffix(hostname, ".localhost")
+}
@@ -0,0 +1,58 @@
+package network
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestValidateURLDenyPrivateAddresses(t *testing.T) {
+	options := &URLOptions{DenyPrivateAddresses: true}
+	for _, tt := range []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://example.com/", valid: true},
+		{url: "https://0xdead.example.com/", valid: true},
+		{url: "https://[2002:808:808::]/", valid: true},
+		{url: "http://127.0.0.1/"},
+		{url: "http://localhost/"},
+		{url: "http://2130706433/"},
+		{url: "http://0x7f000001/"},
+		{url: "http://0X7F.0.0.1/"},
+		{url: "http://127.0.0.0x1/"},
+		{url: "http://0177.0.0.1/"},
+		{url: "http://127.1/"},
+		{url: "http://[::ffff:127.0.0.1]/"},
+		{url: "http://[::127.0.0.1]/"},
+		{url: "http://[2002:7f00:1::]/"},
+		{url: "http://[64:ff9b::10.0.0.1]/"},
+	} {
+		if errs := ValidateURL(tt.url, options); (len(errs) == 0) != tt.valid {
+			t.Errorf("ValidateURL(%q) = %v, want valid: %v", tt.url, errs, tt.valid)
+		}
+	}
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	for _, tt := range []struct {
+		addr string
+		want bool
+	}{
+		{addr: "8.8.8.8", want: true},
+		{addr: "2606:4700::1111", want: true},
+		{addr: "2002:808:808::", want: true},
+		{addr: "::8.8.8.8", want: true},
+		{addr: "10.0.0.1"},
+		{addr: "::"},
+		{addr: "::1"},
+		{addr: "::127.0.0.1"},
+		{addr: "::ffff:192.168.0.1"},
+		{addr: "2002:7f00:1::"},
+		{addr: "2002:c0a8:1::1"},
+		{addr: "64:ff9b::127.0.0.1"},
+	} {
+		if got := IsPublicAddress(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("IsPublicAddress(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
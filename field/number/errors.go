@@ -0,0 +1,54 @@
+package number
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	ErrTooSmallMessage          = "number must be greater than or equal to %s"
+	ErrTooSmallExclusiveMessage = "number must be greater than %s"
+	ErrTooLargeMessage          = "number must be less than or equal to %s"
+	ErrTooLargeExclusiveMessage = "number must be less than %s"
+	ErrNotMultipleMessage       = "number must be a multiple of %s"
+	ErrTooManyDigitsMessage     = "number must have at most %d significant digits"
+	ErrTooManyDecimalsMessage   = "number must have at most %d decimal places"
+)
+
+var (
+	ErrInvalidNumber = govalidatorfield.NewError(
+		"number.invalid_number",
+		"value is not a valid number",
+	)
+	ErrUnsupportedType = govalidatorfield.NewError(
+		"number.unsupported_type",
+		"number type is not supported",
+	)
+	ErrOutOfRange = govalidatorfield.NewError(
+		"number.out_of_range",
+		"number exponent is out of the supported range",
+	)
+	ErrNotFinite = govalidatorfield.NewError(
+		"number.not_finite",
+		"number must be finite",
+	)
+	ErrTooSmall = govalidatorfield.NewError(
+		"number.too_small",
+		"number is too small",
+	)
+	ErrTooLarge = govalidatorfield.NewError(
+		"number.too_large",
+		"number is too large",
+	)
+	ErrNotMultiple = govalidatorfield.NewError(
+		"number.not_multiple",
+		"number is not a multiple of the step",
+	)
+	ErrTooManyDigits = govalidatorfield.NewError(
+		"number.too_many_digits",
+		"number has too many significant digits",
+	)
+	ErrTooManyDecimals = govalidatorfield.NewError(
+		"number.too_many_decimals",
+		"number has too many decimal places",
+	)
+)
@@ -0,0 +1,251 @@
+package number
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type (
+	// value is a parsed number
+	value struct {
+		// rat is the exact value of a finite number
+		rat *big.Rat
+
+		// nan determines if the number is NaN
+		nan bool
+
+		// infinity is the sign of an infinite number, zero if the number is not infinite
+		infinity int
+
+		// literal is the decimal literal of a finite number, empty if it has no finite decimal expansion
+		literal string
+	}
+)
+
+// ToRat converts a number to its exact rational value, e.g. to build the bounds of the options from a string
+//
+// Parameters:
+//
+//   - number: the number, either an integer, a float, a json.Number, a decimal string or a big.Int, big.Float or
+//     big.Rat, or a pointer to any of them
+//
+// Returns:
+//
+//   - *big.Rat: the exact value of the number
+//   - error: if the number is not valid, is not finite or its type is not supported
+func ToRat(number any) (*big.Rat, error) {
+	parsed, err := parse(number)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.rat == nil {
+		return nil, ErrNotFinite
+	}
+	return parsed.rat, nil
+}
+
+// parse parses a number. Floats are converted from their shortest decimal representation, so 0.1 is parsed as
+// exactly 1/10 instead of its binary approximation
+//
+// Parameters:
+//
+//   - number: the number
+//
+// Returns:
+//
+//   - *value: the parsed number
+//   - error: if the number is not valid or its type is not supported
+func parse(number any) (*value, error) {
+	switch typedNumber := number.(type) {
+	case json.Number:
+		return parseLiteral(string(typedNumber))
+	case *big.Int:
+		if typedNumber == nil {
+			return nil, ErrInvalidNumber
+		}
+		return &value{rat: new(big.Rat).SetInt(typedNumber), literal: typedNumber.String()}, nil
+	case big.Int:
+		return parse(&typedNumber)
+	case *big.Float:
+		if typedNumber == nil {
+			return nil, ErrInvalidNumber
+		}
+		if typedNumber.IsInf() {
+			return &value{infinity: typedNumber.Sign()}, nil
+		}
+		return parseLiteral(typedNumber.Text('g', -1))
+	case big.Float:
+		return parse(&typedNumber)
+	case *big.Rat:
+		if typedNumber == nil {
+			return nil, ErrInvalidNumber
+		}
+		parsed := &value{rat: new(big.Rat).Set(typedNumber)}
+		if scale, exact := typedNumber.FloatPrec(); exact {
+			parsed.literal = typedNumber.FloatString(scale)
+		}
+		return parsed, nil
+	case big.Rat:
+		return parse(&typedNumber)
+	}
+
+	// Check the kind of the number, so named numeric types are supported
+	reflectedNumber := reflect.ValueOf(number)
+	switch reflectedNumber.Kind() {
+	case reflect.Ptr:
+		if reflectedNumber.IsNil() {
+			return nil, ErrInvalidNumber
+		}
+		return parse(reflectedNumber.Elem().Interface())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		literal := strconv.FormatInt(reflectedNumber.Int(), 10)
+		return &value{rat: new(big.Rat).SetInt64(reflectedNumber.Int()), literal: literal}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		literal := strconv.FormatUint(reflectedNumber.Uint(), 10)
+		return &value{rat: new(big.Rat).SetUint64(reflectedNumber.Uint()), literal: literal}, nil
+	case reflect.Float32, reflect.Float64:
+		float := reflectedNumber.Float()
+		switch {
+		case math.IsNaN(float):
+			return &value{nan: true}, nil
+		case math.IsInf(float, 0):
+			if float > 0 {
+				return &value{infinity: 1}, nil
+			}
+			return &value{infinity: -1}, nil
+		}
+		bitSize := 64
+		if reflectedNumber.Kind() == reflect.Float32 {
+			bitSize = 32
+		}
+		return parseLiteral(strconv.FormatFloat(float, 'g', -1, bitSize))
+	case reflect.String:
+		return parseLiteral(reflectedNumber.String())
+	}
+	return nil, ErrUnsupportedType
+}
+
+// parseLiteral parses a decimal literal with an optional sign, fraction and exponent, e.g. '-12.50' or '1e+21'
+//
+// Parameters:
+//
+//   - literal: the decimal literal
+//
+// Returns:
+//
+//   - *value: the parsed number
+//   - error: if the literal is not valid or its exponent is out of range
+func parseLiteral(literal string) (*value, error) {
+	mantissa, exponent, err := splitLiteral(literal)
+	if err != nil {
+		return nil, err
+	}
+	if exponent > MaximumExponent || exponent < -MaximumExponent {
+		return nil, ErrOutOfRange
+	}
+
+	// The literal is validated, so it can be parsed as an exact rational
+	rat, ok := new(big.Rat).SetString(mantissa + "e" + strconv.Itoa(exponent))
+	if !ok {
+		return nil, ErrInvalidNumber
+	}
+	return &value{rat: rat, literal: literal}, nil
+}
+
+// splitLiteral splits a decimal literal into its mantissa and its exponent
+//
+// Parameters:
+//
+//   - literal: the decimal literal
+//
+// Returns:
+//
+//   - string: the signed mantissa
+//   - int: the exponent
+//   - error: if the literal is not valid
+func splitLiteral(literal string) (string, int, error) {
+	mantissa, exponentLiteral := literal, ""
+	if index := strings.IndexAny(literal, "eE"); index >= 0 {
+		mantissa, exponentLiteral = literal[:index], literal[index+1:]
+	}
+
+	// Check the mantissa, which must have at least a digit and at most a decimal point
+	unsignedMantissa := strings.TrimPrefix(strings.TrimPrefix(mantissa, "-"), "+")
+	if len(unsignedMantissa) < len(mantissa)-1 {
+		return "", 0, ErrInvalidNumber
+	}
+	integerPart, fractionPart, _ := strings.Cut(unsignedMantissa, ".")
+	if integerPart+fractionPart == "" || !isDigits(integerPart) || !isDigits(fractionPart) {
+		return "", 0, ErrInvalidNumber
+	}
+
+	// Check the exponent
+	if exponentLiteral == "" {
+		if len(literal) > len(mantissa) {
+			return "", 0, ErrInvalidNumber
+		}
+		return mantissa, 0, nil
+	}
+	unsignedExponent := strings.TrimPrefix(strings.TrimPrefix(exponentLiteral, "-"), "+")
+	if unsignedExponent == "" || !isDigits(unsignedExponent) {
+		return "", 0, ErrInvalidNumber
+	}
+	exponent, err := strconv.Atoi(exponentLiteral)
+	if err != nil {
+		return "", 0, ErrOutOfRange
+	}
+	return mantissa, exponent, nil
+}
+
+// digits returns the number of significant digits and the number of decimal places of a decimal literal, where the
+// trailing zeros are significant, e.g. '0.050' has 2 significant digits and 3 decimal places
+//
+// Parameters:
+//
+//   - literal: the decimal literal, which must be valid
+//
+// Returns:
+//
+//   - int: the number of significant digits
+//   - int: the number of decimal places
+func digits(literal string) (int, int) {
+	mantissa, exponent, _ := splitLiteral(literal)
+	mantissa = strings.TrimLeft(mantissa, "+-")
+	integerPart, fractionPart, _ := strings.Cut(mantissa, ".")
+
+	// Shift the decimal point by the exponent
+	scale := len(fractionPart) - exponent
+	significantDigits := len(strings.TrimLeft(integerPart+fractionPart, "0"))
+	if scale < 0 {
+		if significantDigits > 0 {
+			significantDigits -= scale
+		}
+		scale = 0
+	}
+	if significantDigits == 0 {
+		significantDigits = 1
+	}
+	return significantDigits, scale
+}
+
+// isDigits checks if a string only contains ASCII digits
+//
+// Parameters:
+//
+//   - s: the string
+//
+// Returns:
+//
+//   - bool: true if the string only contains ASCII digits, false otherwise
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
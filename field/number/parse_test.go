@@ -0,0 +1,86 @@
+package number
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestToRat(t *testing.T) {
+	type amount int16
+	maximumUint64 := uint64(math.MaxUint64)
+	hugeInt, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	for _, tt := range []struct {
+		name   string
+		number any
+		want   string
+		err    error
+	}{
+		{name: "int", number: -42, want: "-42"},
+		{name: "named int", number: amount(7), want: "7"},
+		{name: "minimum int64", number: int64(math.MinInt64), want: "-9223372036854775808"},
+		{name: "maximum uint64", number: maximumUint64, want: "18446744073709551615"},
+		{name: "pointer", number: &maximumUint64, want: "18446744073709551615"},
+		{name: "float shortest representation", number: 0.1, want: "1/10"},
+		{name: "float32 shortest representation", number: float32(0.1), want: "1/10"},
+		{name: "json.Number", number: json.Number("-12.50"), want: "-25/2"},
+		{name: "string exponent", number: "1e+21", want: "1000000000000000000000"},
+		{name: "big.Int", number: hugeInt, want: "123456789012345678901234567890"},
+		{name: "big.Int value", number: *big.NewInt(5), want: "5"},
+		{name: "big.Float", number: big.NewFloat(2.5), want: "5/2"},
+		{name: "big.Rat", number: big.NewRat(1, 3), want: "1/3"},
+		{name: "NaN", number: math.NaN(), err: ErrNotFinite},
+		{name: "infinity", number: math.Inf(1), err: ErrNotFinite},
+		{name: "big.Float infinity", number: new(big.Float).SetInf(true), err: ErrNotFinite},
+		{name: "nil pointer", number: (*int)(nil), err: ErrInvalidNumber},
+		{name: "nil big.Int", number: (*big.Int)(nil), err: ErrInvalidNumber},
+		{name: "unsupported type", number: true, err: ErrUnsupportedType},
+		{name: "invalid literal", number: "1.2.3", err: ErrInvalidNumber},
+		{name: "double sign", number: "--1", err: ErrInvalidNumber},
+		{name: "missing digits", number: ".", err: ErrInvalidNumber},
+		{name: "missing exponent", number: "1e", err: ErrInvalidNumber},
+		{name: "hexadecimal", number: "0x10", err: ErrInvalidNumber},
+		{name: "exponent out of range", number: "1e999999999", err: ErrOutOfRange},
+		{name: "exponent overflow", number: json.Number("1e99999999999999999999"), err: ErrOutOfRange},
+		{name: "negative exponent out of range", number: "1e-4097", err: ErrOutOfRange},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := ToRat(tt.number)
+				if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+					t.Fatalf("ToRat(%v) = %v, want %v", tt.number, err, tt.err)
+				}
+				if tt.err == nil && got.RatString() != tt.want {
+					t.Errorf("ToRat(%v) = %s, want %s", tt.number, got.RatString(), tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestDigits(t *testing.T) {
+	for _, tt := range []struct {
+		literal           string
+		significantDigits int
+		scale             int
+	}{
+		{literal: "123", significantDigits: 3},
+		{literal: "-12.50", significantDigits: 4, scale: 2},
+		{literal: "0.050", significantDigits: 2, scale: 3},
+		{literal: "0", significantDigits: 1},
+		{literal: "0.00", significantDigits: 1, scale: 2},
+		{literal: "1e3", significantDigits: 4},
+		{literal: "1.5e-3", significantDigits: 2, scale: 4},
+		{literal: "12.345e1", significantDigits: 5, scale: 2},
+	} {
+		significantDigits, scale := digits(tt.literal)
+		if significantDigits != tt.significantDigits || scale != tt.scale {
+			t.Errorf(
+				"digits(%q) = %d, %d, want %d, %d",
+				tt.literal, significantDigits, scale, tt.significantDigits, tt.scale,
+			)
+		}
+	}
+}
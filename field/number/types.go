@@ -0,0 +1,53 @@
+package number
+
+import (
+	"math/big"
+)
+
+type (
+	// Options is the numeric constraints struct, where the nil bounds and the zero limits are not checked
+	Options struct {
+		// Minimum is the lower bound of the number
+		Minimum *big.Rat
+
+		// ExclusiveMinimum determines if the number must be strictly greater than the minimum
+		ExclusiveMinimum bool
+
+		// Maximum is the upper bound of the number
+		Maximum *big.Rat
+
+		// ExclusiveMaximum determines if the number must be strictly less than the maximum
+		ExclusiveMaximum bool
+
+		// MultipleOf is the positive step the number must be a multiple of, e.g. 1 for integers or 0.01 for cents
+		MultipleOf *big.Rat
+
+		// FiniteOnly determines if NaN and the infinities are rejected
+		FiniteOnly bool
+
+		// MaximumSignificantDigits is the maximum number of significant digits
+		MaximumSignificantDigits int
+
+		// MaximumScale is the maximum number of decimal places
+		MaximumScale int
+	}
+)
+
+const (
+	// LimitMetadataKey is the metadata key of the violated limit in the validation errors
+	LimitMetadataKey = "limit"
+
+	// MaximumExponent is the maximum absolute decimal exponent of the parsed numbers, so values like '1e999999999'
+	// cannot exhaust the memory when converted to exact rationals
+	MaximumExponent = 4096
+)
+
+// hasConstraints checks if any constraint other than FiniteOnly is set
+//
+// Returns:
+//
+//   - bool: true if any constraint is set, false otherwise
+func (o *Options) hasConstraints() bool {
+	return o.Minimum != nil || o.Maximum != nil || o.MultipleOf != nil || o.MaximumSignificantDigits > 0 ||
+		o.MaximumScale > 0
+}
@@ -0,0 +1,194 @@
+package number
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Validate validates a number against the numeric constraints. The comparisons are made on the exact values, so they
+// cannot overflow nor lose precision
+//
+// Parameters:
+//
+//   - number: the number, either an integer, a float, a json.Number, a decimal string or a big.Int, big.Float or
+//     big.Rat, or a pointer to any of them
+//   - options: the numeric constraints (optional, if nil only the number format is checked)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the number is valid
+func Validate(number any, options *Options) []error {
+	parsed, err := parse(number)
+	if err != nil {
+		return []error{err}
+	}
+	if options == nil {
+		return nil
+	}
+
+	// Check if the number is not finite, NaN cannot satisfy any constraint
+	if parsed.nan {
+		if options.FiniteOnly || options.hasConstraints() {
+			return []error{ErrNotFinite}
+		}
+		return nil
+	}
+	if parsed.infinity != 0 {
+		if options.FiniteOnly {
+			return []error{ErrNotFinite}
+		}
+		return validateInfinity(parsed.infinity, options)
+	}
+
+	var errs []error
+
+	// Check the bounds of the number
+	if options.Minimum != nil {
+		comparison := parsed.rat.Cmp(options.Minimum)
+		if comparison < 0 || (options.ExclusiveMinimum && comparison == 0) {
+			errs = append(errs, newTooSmallError(options))
+		}
+	}
+	if options.Maximum != nil {
+		comparison := parsed.rat.Cmp(options.Maximum)
+		if comparison > 0 || (options.ExclusiveMaximum && comparison == 0) {
+			errs = append(errs, newTooLargeError(options))
+		}
+	}
+
+	// Check if the number is a multiple of the step
+	if options.MultipleOf != nil && options.MultipleOf.Sign() > 0 {
+		if !new(big.Rat).Quo(parsed.rat, options.MultipleOf).IsInt() {
+			limit := ratString(options.MultipleOf)
+			errs = append(
+				errs,
+				ErrNotMultiple.
+					WithMessage(fmt.Sprintf(ErrNotMultipleMessage, limit)).
+					WithMetadata(LimitMetadataKey, limit),
+			)
+		}
+	}
+
+	// Check the significant digits and the decimal places of the number
+	if options.MaximumSignificantDigits <= 0 && options.MaximumScale <= 0 {
+		return errs
+	}
+	significantDigits, scale := -1, -1
+	if parsed.literal != "" {
+		significantDigits, scale = digits(parsed.literal)
+	}
+	if options.MaximumSignificantDigits > 0 && (significantDigits < 0 ||
+		significantDigits > options.MaximumSignificantDigits) {
+		errs = append(
+			errs,
+			ErrTooManyDigits.
+				WithMessage(fmt.Sprintf(ErrTooManyDigitsMessage, options.MaximumSignificantDigits)).
+				WithMetadata(LimitMetadataKey, fmt.Sprint(options.MaximumSignificantDigits)),
+		)
+	}
+	if options.MaximumScale > 0 && (scale < 0 || scale > options.MaximumScale) {
+		errs = append(
+			errs,
+			ErrTooManyDecimals.
+				WithMessage(fmt.Sprintf(ErrTooManyDecimalsMessage, options.MaximumScale)).
+				WithMetadata(LimitMetadataKey, fmt.Sprint(options.MaximumScale)),
+		)
+	}
+	return errs
+}
+
+// NewRule creates a field rule that validates a number against the numeric constraints, which can be added to a
+// mapper field, e.g. mapper.AddFieldRule("Amount", number.NewRule(options))
+//
+// Parameters:
+//
+//   - options: the numeric constraints (optional, if nil only the number format is checked)
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func NewRule(options *Options) func(fieldValue any) []error {
+	return func(fieldValue any) []error {
+		return Validate(fieldValue, options)
+	}
+}
+
+// validateInfinity validates an infinite number against the numeric constraints
+//
+// Parameters:
+//
+//   - sign: the sign of the infinity
+//   - options: the numeric constraints
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the number is valid
+func validateInfinity(sign int, options *Options) []error {
+	var errs []error
+
+	// Check the bounds, which are only exceeded by the infinity of the same sign
+	if sign < 0 && options.Minimum != nil {
+		errs = append(errs, newTooSmallError(options))
+	}
+	if sign > 0 && options.Maximum != nil {
+		errs = append(errs, newTooLargeError(options))
+	}
+
+	// The infinities have no step, significant digits nor decimal places
+	if options.MultipleOf != nil || options.MaximumSignificantDigits > 0 || options.MaximumScale > 0 {
+		errs = append(errs, ErrNotFinite)
+	}
+	return errs
+}
+
+// newTooSmallError creates the validation error of a number less than the minimum
+//
+// Parameters:
+//
+//   - options: the numeric constraints
+//
+// Returns:
+//
+//   - error: the validation error
+func newTooSmallError(options *Options) error {
+	limit := ratString(options.Minimum)
+	message := ErrTooSmallMessage
+	if options.ExclusiveMinimum {
+		message = ErrTooSmallExclusiveMessage
+	}
+	return ErrTooSmall.WithMessage(fmt.Sprintf(message, limit)).WithMetadata(LimitMetadataKey, limit)
+}
+
+// newTooLargeError creates the validation error of a number greater than the maximum
+//
+// Parameters:
+//
+//   - options: the numeric constraints
+//
+// Returns:
+//
+//   - error: the validation error
+func newTooLargeError(options *Options) error {
+	limit := ratString(options.Maximum)
+	message := ErrTooLargeMessage
+	if options.ExclusiveMaximum {
+		message = ErrTooLargeExclusiveMessage
+	}
+	return ErrTooLarge.WithMessage(fmt.Sprintf(message, limit)).WithMetadata(LimitMetadataKey, limit)
+}
+
+// ratString returns the decimal representation of a rational, or its fraction if it has no finite decimal expansion
+//
+// Parameters:
+//
+//   - rat: the rational
+//
+// Returns:
+//
+//   - string: the representation of the rational
+func ratString(rat *big.Rat) string {
+	if scale, exact := rat.FloatPrec(); exact {
+		return rat.FloatString(scale)
+	}
+	return rat.RatString()
+}
@@ -0,0 +1,174 @@
+package number
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestValidate(t *testing.T) {
+	zero, ten := big.NewRat(0, 1), big.NewRat(10, 1)
+	cent := big.NewRat(1, 100)
+	maximumInt64 := new(big.Rat).SetInt64(math.MaxInt64)
+	for _, tt := range []struct {
+		name    string
+		number  any
+		options *Options
+		want    []error
+		limit   string
+	}{
+		{name: "no options", number: 5},
+		{name: "inclusive minimum", number: 0, options: &Options{Minimum: zero}},
+		{name: "below minimum", number: -1, options: &Options{Minimum: zero}, want: []error{ErrTooSmall}, limit: "0"},
+		{
+			name:    "exclusive minimum",
+			number:  uint8(0),
+			options: &Options{Minimum: zero, ExclusiveMinimum: true},
+			want:    []error{ErrTooSmall},
+			limit:   "0",
+		},
+		{name: "inclusive maximum", number: 10.0, options: &Options{Maximum: ten}},
+		{
+			name:    "above maximum",
+			number:  json.Number("10.000001"),
+			options: &Options{Maximum: ten},
+			want:    []error{ErrTooLarge},
+			limit:   "10",
+		},
+		{
+			name:    "exclusive maximum",
+			number:  big.NewInt(10),
+			options: &Options{Maximum: ten, ExclusiveMaximum: true},
+			want:    []error{ErrTooLarge},
+			limit:   "10",
+		},
+		{
+			name:    "maximum uint64 above maximum int64",
+			number:  uint64(math.MaxUint64),
+			options: &Options{Maximum: maximumInt64},
+			want:    []error{ErrTooLarge},
+			limit:   "9223372036854775807",
+		},
+		{
+			name:    "maximum int64 inside the bounds",
+			number:  int64(math.MaxInt64),
+			options: &Options{Maximum: maximumInt64},
+		},
+		{
+			name:    "big.Int above maximum int64",
+			number:  new(big.Int).Lsh(big.NewInt(1), 200),
+			options: &Options{Maximum: maximumInt64},
+			want:    []error{ErrTooLarge},
+			limit:   "9223372036854775807",
+		},
+		{name: "float multiple of cents", number: 0.3, options: &Options{MultipleOf: cent}},
+		{name: "json.Number multiple of cents", number: json.Number("19.99"), options: &Options{MultipleOf: cent}},
+		{
+			name:    "not a multiple",
+			number:  "0.001",
+			options: &Options{MultipleOf: cent},
+			want:    []error{ErrNotMultiple},
+			limit:   "0.01",
+		},
+		{name: "multiple of a big.Rat", number: big.NewRat(2, 3), options: &Options{MultipleOf: big.NewRat(1, 3)}},
+		{name: "significant digits", number: "123.45", options: &Options{MaximumSignificantDigits: 5}},
+		{
+			name:    "too many significant digits",
+			number:  json.Number("123.450"),
+			options: &Options{MaximumSignificantDigits: 5},
+			want:    []error{ErrTooManyDigits},
+			limit:   "5",
+		},
+		{
+			name:    "too many significant digits of an integer",
+			number:  uint32(123456),
+			options: &Options{MaximumSignificantDigits: 5},
+			want:    []error{ErrTooManyDigits},
+			limit:   "5",
+		},
+		{name: "scale", number: float32(1.25), options: &Options{MaximumScale: 2}},
+		{
+			name:    "too many decimals",
+			number:  "1.250",
+			options: &Options{MaximumScale: 2},
+			want:    []error{ErrTooManyDecimals},
+			limit:   "2",
+		},
+		{name: "scale of an exponent", number: "125e-2", options: &Options{MaximumScale: 2}},
+		{
+			name:    "big.Rat without decimal expansion",
+			number:  big.NewRat(1, 3),
+			options: &Options{MaximumScale: 10},
+			want:    []error{ErrTooManyDecimals},
+			limit:   "10",
+		},
+		{
+			name:    "several violations",
+			number:  -0.125,
+			options: &Options{Minimum: zero, MultipleOf: cent, MaximumScale: 2},
+			want:    []error{ErrTooSmall, ErrNotMultiple, ErrTooManyDecimals},
+		},
+		{name: "NaN without constraints", number: math.NaN(), options: &Options{}},
+		{name: "NaN finite only", number: math.NaN(), options: &Options{FiniteOnly: true}, want: []error{ErrNotFinite}},
+		{name: "NaN with bounds", number: math.NaN(), options: &Options{Maximum: ten}, want: []error{ErrNotFinite}},
+		{name: "infinity without bounds", number: math.Inf(1), options: &Options{Minimum: zero}},
+		{
+			name:    "infinity above maximum",
+			number:  math.Inf(1),
+			options: &Options{Maximum: ten},
+			want:    []error{ErrTooLarge},
+		},
+		{name: "negative infinity", number: math.Inf(-1), options: &Options{Minimum: zero}, want: []error{ErrTooSmall}},
+		{name: "infinity step", number: math.Inf(1), options: &Options{MultipleOf: cent}, want: []error{ErrNotFinite}},
+		{name: "invalid", number: "ten", options: &Options{}, want: []error{ErrInvalidNumber}},
+		{name: "out of range", number: "1e999999999", want: []error{ErrOutOfRange}},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := Validate(tt.number, tt.options)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("Validate(%v) = %v, want %v", tt.number, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("Validate(%v) = %v, want %v", tt.number, errs, tt.want)
+					}
+				}
+				if tt.limit == "" {
+					return
+				}
+				if limit := govalidatorfield.GetMetadata(errs[0])[LimitMetadataKey]; limit != tt.limit {
+					t.Errorf("Validate(%v) limit = %q, want %q", tt.number, limit, tt.limit)
+				}
+			},
+		)
+	}
+}
+
+func TestNewRule(t *testing.T) {
+	rule := NewRule(&Options{Minimum: big.NewRat(1, 1), MultipleOf: big.NewRat(1, 1)})
+	for _, tt := range []struct {
+		fieldValue any
+		want       []error
+	}{
+		{fieldValue: 3},
+		{fieldValue: json.Number("2")},
+		{fieldValue: 0, want: []error{ErrTooSmall}},
+		{fieldValue: 1.5, want: []error{ErrNotMultiple}},
+		{fieldValue: []int{1}, want: []error{ErrUnsupportedType}},
+	} {
+		errs := rule(tt.fieldValue)
+		if len(errs) != len(tt.want) {
+			t.Fatalf("rule(%v) = %v, want %v", tt.fieldValue, errs, tt.want)
+		}
+		for i := range errs {
+			if !errors.Is(errs[i], tt.want[i]) {
+				t.Errorf("rule(%v) = %v, want %v", tt.fieldValue, errs, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// Argon2iVariant is the identifier of the argon2i variant in an encoded hash
+	Argon2iVariant = "argon2i"
+
+	// Argon2idVariant is the identifier of the argon2id variant in an encoded hash
+	Argon2idVariant = "argon2id"
+
+	// MaximumArgon2Memory is the maximum memory parameter in KiB accepted from an encoded hash, so a corrupt or
+	// hostile hash cannot allocate more than 256 MiB
+	MaximumArgon2Memory = 256 * 1024
+
+	// MaximumArgon2Iterations is the maximum time parameter accepted from an encoded hash
+	MaximumArgon2Iterations = 64
+)
+
+type (
+	// BcryptComparator is the bcrypt implementation of the HashComparator interface
+	BcryptComparator struct{}
+
+	// Argon2Comparator is the argon2 implementation of the HashComparator interface, it expects hashes in the PHC
+	// string format, e.g. '$argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>'
+	Argon2Comparator struct{}
+)
+
+// NewBcryptComparator creates a new bcrypt comparator
+//
+// Returns:
+//
+//   - BcryptComparator: the bcrypt comparator
+func NewBcryptComparator() BcryptComparator {
+	return BcryptComparator{}
+}
+
+// Compare compares a plain password against a bcrypt hash
+//
+// Parameters:
+//
+//   - hash: the bcrypt hash
+//   - password: the plain password
+//
+// Returns:
+//
+//   - bool: true if the password matches the hash, false otherwise
+//   - error: if the hash is malformed
+func (b BcryptComparator) Compare(hash, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, err
+}
+
+// NewArgon2Comparator creates a new argon2 comparator
+//
+// Returns:
+//
+//   - Argon2Comparator: the argon2 comparator
+func NewArgon2Comparator() Argon2Comparator {
+	return Argon2Comparator{}
+}
+
+// Compare compares a plain password against an argon2 encoded hash
+//
+// Parameters:
+//
+//   - hash: the argon2 encoded hash
+//   - password: the plain password
+//
+// Returns:
+//
+//   - bool: true if the password matches the hash, false otherwise
+//   - error: if the hash is malformed, uses an unsupported variant or version, or its parameters are out of range
+func (a Argon2Comparator) Compare(hash, password string) (bool, error) {
+	// Split the encoded hash, the first part is empty since the hash starts with '$'
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" {
+		return false, ErrInvalidArgon2Hash
+	}
+	variant := parts[1]
+	if variant != Argon2iVariant && variant != Argon2idVariant {
+		return false, ErrUnsupportedArgon2Type
+	}
+
+	// Parse the version
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return false, ErrInvalidArgon2Hash
+	}
+	if version != argon2.Version {
+		return false, ErrIncompatibleArgon2Version
+	}
+
+	// Parse the memory, time and parallelism parameters
+	var memory, iterations, parallelism uint32
+	if _, err := fmt.Sscanf(
+		parts[3],
+		"m=%d,t=%d,p=%d",
+		&memory,
+		&iterations,
+		&parallelism,
+	); err != nil {
+		return false, ErrInvalidArgon2Hash
+	}
+
+	// Check the parameters before deriving the key, since argon2 panics on out of range values
+	if iterations < 1 || iterations > MaximumArgon2Iterations {
+		return false, ErrInvalidArgon2Hash
+	}
+	if parallelism < 1 || parallelism > 255 {
+		return false, ErrInvalidArgon2Hash
+	}
+	if memory < 8*parallelism || memory > MaximumArgon2Memory {
+		return false, ErrInvalidArgon2Hash
+	}
+
+	// Decode the salt and the key
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, ErrInvalidArgon2Hash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false, ErrInvalidArgon2Hash
+	}
+
+	// Derive the key from the password with the same parameters
+	var derivedKey []byte
+	if variant == Argon2idVariant {
+		derivedKey = argon2.IDKey(
+			[]byte(password),
+			salt,
+			iterations,
+			memory,
+			uint8(parallelism),
+			uint32(len(key)), //nolint:gosec
+		)
+	} else {
+		derivedKey = argon2.Key(
+			[]byte(password),
+			salt,
+			iterations,
+			memory,
+			uint8(parallelism),
+			uint32(len(key)), //nolint:gosec
+		)
+	}
+	return subtle.ConstantTimeCompare(key, derivedKey) == 1, nil
+}
@@ -0,0 +1,94 @@
+package password
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// newArgon2Hash encodes an argon2 hash of a password in the PHC string format, with small parameters so the tests
+// stay fast
+func newArgon2Hash(t *testing.T, variant, password string) string {
+	t.Helper()
+
+	salt := []byte("0123456789abcdef")
+	var key []byte
+	if variant == Argon2idVariant {
+		key = argon2.IDKey([]byte(password), salt, 1, 64, 1, 32)
+	} else {
+		key = argon2.Key([]byte(password), salt, 1, 64, 1, 32)
+	}
+	return fmt.Sprintf(
+		"$%s$v=%d$m=64,t=1,p=1$%s$%s",
+		variant,
+		argon2.Version,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func TestBcryptComparator(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt.GenerateFromPassword() = %v", err)
+	}
+
+	comparator := NewBcryptComparator()
+	for _, tt := range []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+		err      bool
+	}{
+		{name: "match", hash: string(hash), password: "correct horse", want: true},
+		{name: "mismatch", hash: string(hash), password: "battery staple"},
+		{name: "malformed hash", hash: "not a bcrypt hash", password: "correct horse", err: true},
+	} {
+		got, err := comparator.Compare(tt.hash, tt.password)
+		if got != tt.want || (err != nil) != tt.err {
+			t.Errorf("%s: Compare() = %v, %v, want %v, error %v", tt.name, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestArgon2Comparator(t *testing.T) {
+	argon2idHash := newArgon2Hash(t, Argon2idVariant, "correct horse")
+	argon2iHash := newArgon2Hash(t, Argon2iVariant, "correct horse")
+	salt := base64.RawStdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	key := base64.RawStdEncoding.EncodeToString([]byte("key"))
+	saltAndKey := "$" + salt + "$" + key
+
+	comparator := NewArgon2Comparator()
+	for _, tt := range []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+		err      error
+	}{
+		{name: "argon2id match", hash: argon2idHash, password: "correct horse", want: true},
+		{name: "argon2id mismatch", hash: argon2idHash, password: "battery staple"},
+		{name: "argon2i match", hash: argon2iHash, password: "correct horse", want: true},
+		{name: "argon2i mismatch", hash: argon2iHash, password: "battery staple"},
+		{name: "missing parts", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt, err: ErrInvalidArgon2Hash},
+		{name: "argon2d", hash: "$argon2d$v=19$m=64,t=1,p=1" + saltAndKey, err: ErrUnsupportedArgon2Type},
+		{name: "old version", hash: "$argon2id$v=16$m=64,t=1,p=1" + saltAndKey, err: ErrIncompatibleArgon2Version},
+		{name: "invalid parameters", hash: "$argon2id$v=19$m=x,t=1,p=1" + saltAndKey, err: ErrInvalidArgon2Hash},
+		{name: "zero iterations", hash: "$argon2id$v=19$m=64,t=0,p=1" + saltAndKey, err: ErrInvalidArgon2Hash},
+		{name: "too much memory", hash: "$argon2id$v=19$m=999999,t=1,p=1" + saltAndKey, err: ErrInvalidArgon2Hash},
+		{name: "too little memory", hash: "$argon2id$v=19$m=7,t=1,p=1" + saltAndKey, err: ErrInvalidArgon2Hash},
+		{name: "zero parallelism", hash: "$argon2id$v=19$m=64,t=1,p=0" + saltAndKey, err: ErrInvalidArgon2Hash},
+		{name: "invalid salt", hash: "$argon2id$v=19$m=64,t=1,p=1$!$" + key, err: ErrInvalidArgon2Hash},
+		{name: "empty key", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$", err: ErrInvalidArgon2Hash},
+	} {
+		got, err := comparator.Compare(tt.hash, tt.password)
+		if got != tt.want || !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("%s: Compare() = %v, %v, want %v, %v", tt.name, got, err, tt.want, tt.err)
+		}
+	}
+}
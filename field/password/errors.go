@@ -1,8 +1,31 @@
 package password
 
+import (
+	"errors"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
 var (
 	ErrMinimumLength       = "password must be longer than %d"
 	ErrMinimumSpecialCount = "password must have at least %d special characters"
 	ErrMinimumNumbersCount = "password must have at least %d numbers"
 	ErrMinimumCapsCount    = "password must have at least %d capital letters"
 )
+
+var (
+	ErrReused = govalidatorfield.NewError(
+		"password.reused",
+		"password must not match any of the recently used passwords",
+	)
+)
+
+var (
+	ErrNilHistoryStore           = errors.New("password history store cannot be nil")
+	ErrNilHistoryChecker         = errors.New("password history checker cannot be nil")
+	ErrNilHashComparator         = errors.New("password hash comparator cannot be nil")
+	ErrEmptyUserID               = errors.New("user id cannot be empty")
+	ErrInvalidArgon2Hash         = errors.New("invalid argon2 encoded hash")
+	ErrUnsupportedArgon2Type     = errors.New("unsupported argon2 variant, must be argon2i or argon2id")
+	ErrIncompatibleArgon2Version = errors.New("incompatible argon2 version")
+)
@@ -0,0 +1,137 @@
+package password
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+const (
+	// DefaultGeneratedLength is the length of the generated passwords when the policy does not require a longer one
+	DefaultGeneratedLength = 16
+
+	// LowercaseCharacters are the lowercase characters used to generate passwords
+	LowercaseCharacters = "abcdefghijklmnopqrstuvwxyz"
+
+	// CapsCharacters are the capital letters used to generate passwords
+	CapsCharacters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	// NumbersCharacters are the numbers used to generate passwords
+	NumbersCharacters = "0123456789"
+
+	// SpecialCharacters are the special characters used to generate passwords, it matches the characters counted as
+	// special by the password policy
+	SpecialCharacters = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+)
+
+// Generate generates a random password that satisfies the password policy
+//
+// Parameters:
+//
+//   - options: the password policy (optional, can be nil)
+//
+// Returns:
+//
+//   - string: the generated password
+//   - error: if the random source failed
+func Generate(options *Options) (string, error) {
+	return GenerateWithLength(options, DefaultGeneratedLength)
+}
+
+// GenerateWithLength generates a random password with the given length that satisfies the password policy. If the
+// policy requires more characters than the given length, the required length is used instead
+//
+// Parameters:
+//
+//   - options: the password policy (optional, can be nil)
+//   - length: the length of the password
+//
+// Returns:
+//
+//   - string: the generated password
+//   - error: if the random source failed
+func GenerateWithLength(options *Options, length int) (string, error) {
+	if options == nil {
+		options = &Options{}
+	}
+
+	// Get the required length of the password
+	requiredCount := max(options.MinimumCapsCount, 0) +
+		max(options.MinimumNumbersCount, 0) +
+		max(options.MinimumSpecialCount, 0)
+	length = max(length, options.MinimumLength, requiredCount)
+
+	// Add the required characters of each class
+	password := make([]byte, 0, length)
+	for _, class := range []struct {
+		characters string
+		count      int
+	}{
+		{CapsCharacters, options.MinimumCapsCount},
+		{NumbersCharacters, options.MinimumNumbersCount},
+		{SpecialCharacters, options.MinimumSpecialCount},
+	} {
+		for i := 0; i < class.count; i++ {
+			character, err := randomCharacter(class.characters)
+			if err != nil {
+				return "", err
+			}
+			password = append(password, character)
+		}
+	}
+
+	// Fill the remaining characters with any class
+	allCharacters := LowercaseCharacters + CapsCharacters + NumbersCharacters + SpecialCharacters
+	for len(password) < length {
+		character, err := randomCharacter(allCharacters)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, character)
+	}
+
+	// Shuffle the password, so the required characters are not at the start
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+// randomCharacter returns a uniformly random character from the given characters
+//
+// Parameters:
+//
+//   - characters: the characters to pick from
+//
+// Returns:
+//
+//   - byte: the random character
+//   - error: if the random source failed
+func randomCharacter(characters string) (byte, error) {
+	index, err := randomIndex(len(characters))
+	if err != nil {
+		return 0, err
+	}
+	return characters[index], nil
+}
+
+// randomIndex returns a uniformly random index in [0, n)
+//
+// Parameters:
+//
+//   - n: the upper bound of the index
+//
+// Returns:
+//
+//   - int: the random index
+//   - error: if the random source failed
+func randomIndex(n int) (int, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(index.Int64()), nil
+}
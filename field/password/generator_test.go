@@ -0,0 +1,101 @@
+package password
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+// generatorCase is a random password policy and length used to check the generator
+type generatorCase struct {
+	MinimumLength       uint8
+	MinimumSpecialCount uint8
+	MinimumNumbersCount uint8
+	MinimumCapsCount    uint8
+	Length              uint8
+}
+
+// checkGenerated generates a password for the given case and checks it satisfies the policy
+func checkGenerated(t *testing.T, c generatorCase) bool {
+	t.Helper()
+
+	// Keep the counts small, so the generator stays fast
+	options := &Options{
+		MinimumLength:       int(c.MinimumLength % 64),
+		MinimumSpecialCount: int(c.MinimumSpecialCount % 16),
+		MinimumNumbersCount: int(c.MinimumNumbersCount % 16),
+		MinimumCapsCount:    int(c.MinimumCapsCount % 16),
+	}
+	length := int(c.Length % 64)
+
+	password, err := GenerateWithLength(options, length)
+	if err != nil {
+		t.Errorf("GenerateWithLength(%+v, %d) returned error: %v", options, length, err)
+		return false
+	}
+
+	// Check the length of the password
+	expectedLength := max(
+		length,
+		options.MinimumLength,
+		options.MinimumSpecialCount+options.MinimumNumbersCount+options.MinimumCapsCount,
+	)
+	if len(password) != expectedLength {
+		t.Errorf("GenerateWithLength(%+v, %d) length = %d, want %d", options, length, len(password), expectedLength)
+		return false
+	}
+
+	// Check the password satisfies the policy
+	if errs := Validate(password, options); len(errs) != 0 {
+		t.Errorf("Validate(%q, %+v) = %v, want nil", password, options, errs)
+		return false
+	}
+	return true
+}
+
+func TestGenerateWithLengthSatisfiesPolicy(t *testing.T) {
+	if err := quick.Check(
+		func(c generatorCase) bool { return checkGenerated(t, c) },
+		&quick.Config{MaxCount: 500},
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGenerateSatisfiesPolicy(t *testing.T) {
+	for _, options := range []*Options{
+		nil,
+		{},
+		{MinimumLength: 8, MinimumSpecialCount: 2, MinimumNumbersCount: 2, MinimumCapsCount: 2},
+		{MinimumSpecialCount: 10, MinimumNumbersCount: 10, MinimumCapsCount: 10},
+	} {
+		password, err := Generate(options)
+		if err != nil {
+			t.Fatalf("Generate(%+v) returned error: %v", options, err)
+		}
+		if len(password) < DefaultGeneratedLength {
+			t.Errorf("Generate(%+v) length = %d, want at least %d", options, len(password), DefaultGeneratedLength)
+		}
+		if errs := Validate(password, options); len(errs) != 0 {
+			t.Errorf("Validate(%q, %+v) = %v, want nil", password, options, errs)
+		}
+	}
+}
+
+func FuzzGenerateWithLength(f *testing.F) {
+	f.Add(uint8(8), uint8(1), uint8(1), uint8(1), uint8(16))
+	f.Add(uint8(0), uint8(0), uint8(0), uint8(0), uint8(0))
+	f.Add(uint8(255), uint8(255), uint8(255), uint8(255), uint8(255))
+	f.Fuzz(
+		func(t *testing.T, minimumLength, specialCount, numbersCount, capsCount, length uint8) {
+			checkGenerated(
+				t, generatorCase{
+					MinimumLength:       minimumLength,
+					MinimumSpecialCount: specialCount,
+					MinimumNumbersCount: numbersCount,
+					MinimumCapsCount:    capsCount,
+					Length:              length,
+				},
+			)
+		},
+	)
+}
@@ -0,0 +1,182 @@
+package password
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// DefaultHistoryChecker is the default implementation of the HistoryChecker interface, it compares the candidate
+	// password against the most recent password hashes returned by the history store
+	DefaultHistoryChecker struct {
+		store      HistoryStore
+		comparator HashComparator
+		depth      int
+	}
+
+	// MemoryHistoryStore is an in-memory implementation of the HistoryStore interface
+	MemoryHistoryStore struct {
+		mutex  sync.RWMutex
+		hashes map[string][]string
+	}
+)
+
+// NewDefaultHistoryChecker creates a new default history checker
+//
+// Parameters:
+//
+//   - store: the store of the password hashes
+//   - comparator: the comparator used to match the candidate against the stored hashes
+//   - depth: the number of most recent passwords that cannot be reused
+//
+// Returns:
+//
+//   - *DefaultHistoryChecker: the default history checker
+//   - error: if the store or the comparator are nil
+func NewDefaultHistoryChecker(
+	store HistoryStore,
+	comparator HashComparator,
+	depth int,
+) (*DefaultHistoryChecker, error) {
+	// Check if the store or the comparator are nil
+	if store == nil {
+		return nil, ErrNilHistoryStore
+	}
+	if comparator == nil {
+		return nil, ErrNilHashComparator
+	}
+
+	return &DefaultHistoryChecker{
+		store:      store,
+		comparator: comparator,
+		depth:      depth,
+	}, nil
+}
+
+// IsReused checks if the candidate password matches any of the most recent passwords of the user
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - userID: the ID of the user
+//   - candidate: the candidate password
+//
+// Returns:
+//
+//   - bool: true if the candidate password was recently used, false otherwise
+//   - error: if the hashes could not be retrieved or compared
+func (d *DefaultHistoryChecker) IsReused(
+	ctx context.Context,
+	userID string,
+	candidate string,
+) (bool, error) {
+	if d == nil {
+		return false, ErrNilHistoryChecker
+	}
+
+	// Check if the user ID is empty or the history is disabled
+	if userID == "" {
+		return false, ErrEmptyUserID
+	}
+	if d.depth <= 0 {
+		return false, nil
+	}
+
+	// Get the most recent password hashes of the user
+	hashes, err := d.store.GetPasswordHashes(ctx, userID, d.depth)
+	if err != nil {
+		return false, err
+	}
+
+	// Compare the candidate against each hash
+	for i, hash := range hashes {
+		if i >= d.depth {
+			break
+		}
+
+		// Check if the context was canceled
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+
+		matches, compareErr := d.comparator.Compare(hash, candidate)
+		if compareErr != nil {
+			return false, compareErr
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NewMemoryHistoryStore creates a new in-memory history store
+//
+// Returns:
+//
+//   - *MemoryHistoryStore: the in-memory history store
+func NewMemoryHistoryStore() *MemoryHistoryStore {
+	return &MemoryHistoryStore{
+		hashes: make(map[string][]string),
+	}
+}
+
+// AddPasswordHash adds a password hash as the most recent password of the user
+//
+// Parameters:
+//
+//   - userID: the ID of the user
+//   - hash: the password hash
+func (m *MemoryHistoryStore) AddPasswordHash(userID, hash string) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	// Initialize the hashes map if it is nil
+	if m.hashes == nil {
+		m.hashes = make(map[string][]string)
+	}
+
+	// Prepend the hash, so the most recent hash is the first one
+	m.hashes[userID] = append([]string{hash}, m.hashes[userID]...)
+}
+
+// GetPasswordHashes returns the most recent password hashes of the user, ordered from newest to oldest
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - userID: the ID of the user
+//   - limit: the maximum number of hashes to return
+//
+// Returns:
+//
+//   - []string: the password hashes
+//   - error: if the context was canceled
+func (m *MemoryHistoryStore) GetPasswordHashes(
+	ctx context.Context,
+	userID string,
+	limit int,
+) ([]string, error) {
+	if m == nil {
+		return nil, ErrNilHistoryStore
+	}
+
+	// Check if the context was canceled
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	// Get a copy of the most recent hashes
+	hashes := m.hashes[userID]
+	if limit > 0 && len(hashes) > limit {
+		hashes = hashes[:limit]
+	}
+	return append([]string(nil), hashes...), nil
+}
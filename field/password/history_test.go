@@ -0,0 +1,194 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+type (
+	// plainComparator is a HashComparator that stores the passwords as their own hashes
+	plainComparator struct {
+		compared []string
+		err      error
+	}
+)
+
+// Compare compares a password against a plain hash, recording the compared hashes
+func (p *plainComparator) Compare(hash, password string) (bool, error) {
+	p.compared = append(p.compared, hash)
+	return hash == password, p.err
+}
+
+func TestMemoryHistoryStore(t *testing.T) {
+	store := NewMemoryHistoryStore()
+	for _, hash := range []string{"first", "second", "third"} {
+		store.AddPasswordHash("alice", hash)
+	}
+	store.AddPasswordHash("bob", "other")
+
+	for _, tt := range []struct {
+		userID string
+		limit  int
+		want   []string
+	}{
+		{userID: "alice", limit: 2, want: []string{"third", "second"}},
+		{userID: "alice", limit: 5, want: []string{"third", "second", "first"}},
+		{userID: "alice", want: []string{"third", "second", "first"}},
+		{userID: "bob", limit: 2, want: []string{"other"}},
+		{userID: "carol", limit: 2, want: []string{}},
+	} {
+		got, err := store.GetPasswordHashes(context.Background(), tt.userID, tt.limit)
+		if err != nil {
+			t.Fatalf("GetPasswordHashes(%q, %d) = %v", tt.userID, tt.limit, err)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("GetPasswordHashes(%q, %d) = %q, want %q", tt.userID, tt.limit, got, tt.want)
+		}
+	}
+
+	// Check the returned hashes are a copy of the stored ones
+	got, _ := store.GetPasswordHashes(context.Background(), "alice", 1)
+	got[0] = "modified"
+	if got, _ = store.GetPasswordHashes(context.Background(), "alice", 1); got[0] != "third" {
+		t.Errorf("GetPasswordHashes() = %q after modifying a previous result, want %q", got, []string{"third"})
+	}
+
+	// Check the canceled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := store.GetPasswordHashes(ctx, "alice", 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetPasswordHashes() = %v, want %v", err, context.Canceled)
+	}
+
+	var nilStore *MemoryHistoryStore
+	nilStore.AddPasswordHash("alice", "first")
+	if _, err := nilStore.GetPasswordHashes(context.Background(), "alice", 1); !errors.Is(err, ErrNilHistoryStore) {
+		t.Errorf("nil GetPasswordHashes() = %v, want %v", err, ErrNilHistoryStore)
+	}
+}
+
+func TestNewDefaultHistoryChecker(t *testing.T) {
+	if _, err := NewDefaultHistoryChecker(nil, &plainComparator{}, 3); !errors.Is(err, ErrNilHistoryStore) {
+		t.Errorf("NewDefaultHistoryChecker() = %v, want %v", err, ErrNilHistoryStore)
+	}
+	if _, err := NewDefaultHistoryChecker(NewMemoryHistoryStore(), nil, 3); !errors.Is(err, ErrNilHashComparator) {
+		t.Errorf("NewDefaultHistoryChecker() = %v, want %v", err, ErrNilHashComparator)
+	}
+}
+
+func TestDefaultHistoryCheckerIsReused(t *testing.T) {
+	store := NewMemoryHistoryStore()
+	for _, hash := range []string{"oldest", "older", "old", "current"} {
+		store.AddPasswordHash("alice", hash)
+	}
+	errCompare := errors.New("compare error")
+
+	for _, tt := range []struct {
+		name       string
+		userID     string
+		candidate  string
+		depth      int
+		compareErr error
+		want       bool
+		compared   []string
+		err        error
+	}{
+		{
+			name:      "current password",
+			userID:    "alice",
+			candidate: "current",
+			depth:     3,
+			want:      true,
+			compared:  []string{"current"},
+		},
+		{
+			name:      "inside the depth",
+			userID:    "alice",
+			candidate: "older",
+			depth:     3,
+			want:      true,
+			compared:  []string{"current", "old", "older"},
+		},
+		{
+			name:      "outside the depth",
+			userID:    "alice",
+			candidate: "oldest",
+			depth:     3,
+			compared:  []string{"current", "old", "older"},
+		},
+		{
+			name:      "new password",
+			userID:    "alice",
+			candidate: "new",
+			depth:     10,
+			compared:  []string{"current", "old", "older", "oldest"},
+		},
+		{name: "history disabled", userID: "alice", candidate: "current"},
+		{name: "user without history", userID: "bob", candidate: "current", depth: 3},
+		{name: "empty user ID", candidate: "current", depth: 3, err: ErrEmptyUserID},
+		{
+			name:       "comparator error",
+			userID:     "alice",
+			candidate:  "current",
+			depth:      3,
+			compareErr: errCompare,
+			err:        errCompare,
+			compared:   []string{"current"},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				comparator := &plainComparator{err: tt.compareErr}
+				checker, err := NewDefaultHistoryChecker(store, comparator, tt.depth)
+				if err != nil {
+					t.Fatalf("NewDefaultHistoryChecker() = %v", err)
+				}
+				got, err := checker.IsReused(context.Background(), tt.userID, tt.candidate)
+				if got != tt.want || !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+					t.Errorf("IsReused(%q) = %v, %v, want %v, %v", tt.candidate, got, err, tt.want, tt.err)
+				}
+				if !slices.Equal(comparator.compared, tt.compared) {
+					t.Errorf("IsReused(%q) compared %q, want %q", tt.candidate, comparator.compared, tt.compared)
+				}
+			},
+		)
+	}
+
+	// Check the canceled context
+	checker, _ := NewDefaultHistoryChecker(store, &plainComparator{}, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := checker.IsReused(ctx, "alice", "current"); !errors.Is(err, context.Canceled) {
+		t.Errorf("IsReused() = %v, want %v", err, context.Canceled)
+	}
+
+	var nilChecker *DefaultHistoryChecker
+	if _, err := nilChecker.IsReused(context.Background(), "alice", "current"); !errors.Is(err, ErrNilHistoryChecker) {
+		t.Errorf("nil IsReused() = %v, want %v", err, ErrNilHistoryChecker)
+	}
+}
+
+func TestDefaultHistoryCheckerWithArgon2(t *testing.T) {
+	store := NewMemoryHistoryStore()
+	for _, password := range []string{"first password", "second password"} {
+		store.AddPasswordHash("alice", newArgon2Hash(t, Argon2idVariant, password))
+	}
+	checker, err := NewDefaultHistoryChecker(store, NewArgon2Comparator(), 2)
+	if err != nil {
+		t.Fatalf("NewDefaultHistoryChecker() = %v", err)
+	}
+	for _, tt := range []struct {
+		candidate string
+		want      bool
+	}{
+		{candidate: "first password", want: true},
+		{candidate: "second password", want: true},
+		{candidate: "third password"},
+	} {
+		if got, err := checker.IsReused(context.Background(), "alice", tt.candidate); got != tt.want || err != nil {
+			t.Errorf("IsReused(%q) = %v, %v, want %v, nil", tt.candidate, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package password
+
+import (
+	"context"
+)
+
+type (
+	// HashComparator is an interface to compare a plain password against a stored password hash
+	HashComparator interface {
+		Compare(hash, password string) (bool, error)
+	}
+
+	// HistoryStore is an interface to retrieve the most recent password hashes of a user
+	HistoryStore interface {
+		GetPasswordHashes(
+			ctx context.Context,
+			userID string,
+			limit int,
+		) ([]string, error)
+	}
+
+	// HistoryChecker is an interface to check if a candidate password was recently used by a user
+	HistoryChecker interface {
+		IsReused(
+			ctx context.Context,
+			userID string,
+			candidate string,
+		) (bool, error)
+	}
+)
@@ -0,0 +1,14 @@
+package password
+
+type (
+	// Options is the password policy struct
+	Options struct {
+		MinimumLength       int
+		MinimumSpecialCount int
+		MinimumNumbersCount int
+		MinimumCapsCount    int
+
+		// HistoryChecker is used to prevent the reuse of recent passwords (optional, can be nil)
+		HistoryChecker HistoryChecker
+	}
+)
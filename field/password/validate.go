@@ -0,0 +1,65 @@
+package password
+
+import (
+	"fmt"
+
+	gostringscount "github.com/ralvarezdev/go-strings/count"
+)
+
+// Validate validates a password against the password policy
+//
+// Parameters:
+//
+//   - password: the password to validate
+//   - options: the password policy (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the policy violations, nil if the password is valid
+func Validate(password string, options *Options) []error {
+	// Check if the password policy is nil
+	if options == nil {
+		return nil
+	}
+
+	var errs []error
+
+	// Check if the password length is less than the minimum length
+	if options.MinimumLength > 0 && len(password) < options.MinimumLength {
+		errs = append(
+			errs,
+			fmt.Errorf(ErrMinimumLength, options.MinimumLength),
+		)
+	}
+
+	// Check if the password contains the minimum special characters
+	if options.MinimumSpecialCount > 0 {
+		if count := gostringscount.Special(password); count < options.MinimumSpecialCount {
+			errs = append(
+				errs,
+				fmt.Errorf(ErrMinimumSpecialCount, options.MinimumSpecialCount),
+			)
+		}
+	}
+
+	// Check if the password contains the minimum numbers
+	if options.MinimumNumbersCount > 0 {
+		if count := gostringscount.Numbers(password); count < options.MinimumNumbersCount {
+			errs = append(
+				errs,
+				fmt.Errorf(ErrMinimumNumbersCount, options.MinimumNumbersCount),
+			)
+		}
+	}
+
+	// Check if the password contains the minimum caps
+	if options.MinimumCapsCount > 0 {
+		if count := gostringscount.Caps(password); count < options.MinimumCapsCount {
+			errs = append(
+				errs,
+				fmt.Errorf(ErrMinimumCapsCount, options.MinimumCapsCount),
+			)
+		}
+	}
+	return errs
+}
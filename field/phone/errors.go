@@ -0,0 +1,46 @@
+package phone
+
+import (
+	"errors"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+var (
+	ErrInvalidMetadata = errors.New("invalid phone number metadata")
+)
+
+var (
+	ErrInvalidFormat = govalidatorfield.NewError(
+		"phone.invalid_format",
+		"phone number must only contain digits, an optional leading plus sign and separators",
+	)
+	ErrMissingRegion = govalidatorfield.NewError(
+		"phone.missing_region",
+		"phone number must be in the international format or have a region",
+	)
+	ErrUnsupportedRegion = govalidatorfield.NewError(
+		"phone.unsupported_region",
+		"phone number region or country calling code is not supported",
+	)
+	ErrRegionNotAllowed = govalidatorfield.NewError(
+		"phone.region_not_allowed",
+		"phone number region is not allowed",
+	)
+	ErrTooShort = govalidatorfield.NewError(
+		"phone.too_short",
+		"phone number is too short",
+	)
+	ErrTooLong = govalidatorfield.NewError(
+		"phone.too_long",
+		"phone number is too long",
+	)
+	ErrInvalidNumber = govalidatorfield.NewError(
+		"phone.invalid_number",
+		"phone number is not valid for its region",
+	)
+	ErrTypeNotAllowed = govalidatorfield.NewError(
+		"phone.type_not_allowed",
+		"phone number type is not allowed",
+	)
+)
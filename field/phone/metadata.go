@@ -0,0 +1,252 @@
+package phone
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type (
+	// Region is the phone number metadata of a region
+	Region struct {
+		// Code is the ISO 3166-1 alpha-2 code of the region
+		Code string
+
+		// CallingCode is the country calling code of the region
+		CallingCode string
+
+		// NationalPrefix is the trunk prefix dialed before the national numbers, e.g. '0'
+		NationalPrefix string
+
+		// Lengths are the possible lengths of the national significant numbers
+		Lengths []int
+
+		mobile    *regexp.Regexp
+		fixedLine *regexp.Regexp
+	}
+
+	// Metadata is the phone number metadata of the supported regions
+	Metadata struct {
+		regions      map[string]*Region
+		callingCodes map[string][]*Region
+	}
+
+	// regionData is the JSON representation of the metadata of a region
+	regionData struct {
+		CallingCode    string `json:"calling_code"`
+		NationalPrefix string `json:"national_prefix,omitempty"`
+		MainRegion     bool   `json:"main_region,omitempty"`
+		Lengths        []int  `json:"lengths"`
+		Mobile         string `json:"mobile"`
+		FixedLine      string `json:"fixed_line"`
+	}
+)
+
+var (
+	//go:embed data/regions.json
+	regionsData []byte
+
+	// defaultMetadata is the parsed bundled metadata
+	defaultMetadata     *Metadata
+	defaultMetadataOnce sync.Once
+)
+
+// LoadMetadata loads the phone number metadata from JSON, e.g.
+// '{"VE": {"calling_code": "58", "national_prefix": "0", "lengths": [10], "mobile": "4\\d{9}", "fixed_line": "2\\d{9}"}}'
+//
+// Parameters:
+//
+//   - reader: the reader of the metadata
+//
+// Returns:
+//
+//   - *Metadata: the phone number metadata
+//   - error: if the metadata could not be read, decoded or compiled
+func LoadMetadata(reader io.Reader) (*Metadata, error) {
+	var data map[string]regionData
+	if err := json.NewDecoder(reader).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	m := &Metadata{
+		regions:      make(map[string]*Region, len(data)),
+		callingCodes: make(map[string][]*Region),
+	}
+	mainRegions := make(map[string]string)
+	for code, regionData := range data {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code == "" || regionData.CallingCode == "" || len(regionData.Lengths) == 0 {
+			return nil, ErrInvalidMetadata
+		}
+
+		// Compile the number type patterns, anchored to the whole national significant number
+		mobile, err := regexp.Compile("^(?:" + regionData.Mobile + ")$")
+		if err != nil {
+			return nil, ErrInvalidMetadata
+		}
+		fixedLine, err := regexp.Compile("^(?:" + regionData.FixedLine + ")$")
+		if err != nil {
+			return nil, ErrInvalidMetadata
+		}
+
+		region := &Region{
+			Code:           code,
+			CallingCode:    regionData.CallingCode,
+			NationalPrefix: regionData.NationalPrefix,
+			Lengths:        regionData.Lengths,
+			mobile:         mobile,
+			fixedLine:      fixedLine,
+		}
+		m.regions[code] = region
+		m.callingCodes[region.CallingCode] = append(m.callingCodes[region.CallingCode], region)
+		if regionData.MainRegion {
+			mainRegions[region.CallingCode] = code
+		}
+	}
+
+	// Sort the regions that share a calling code, with the main region first
+	for callingCode, regions := range m.callingCodes {
+		mainRegion := mainRegions[callingCode]
+		sort.Slice(
+			regions, func(i, j int) bool {
+				if (regions[i].Code == mainRegion) != (regions[j].Code == mainRegion) {
+					return regions[i].Code == mainRegion
+				}
+				return regions[i].Code < regions[j].Code
+			},
+		)
+	}
+	return m, nil
+}
+
+// DefaultMetadata returns the bundled phone number metadata, which covers the main regions of the Americas, Europe
+// and Asia-Pacific
+//
+// Returns:
+//
+//   - *Metadata: the phone number metadata
+func DefaultMetadata() *Metadata {
+	defaultMetadataOnce.Do(
+		func() {
+			// The bundled metadata is embedded and well-formed, so it cannot fail to be loaded
+			defaultMetadata, _ = LoadMetadata(bytes.NewReader(regionsData))
+		},
+	)
+	return defaultMetadata
+}
+
+// Region returns the metadata of a region
+//
+// Parameters:
+//
+//   - code: the ISO 3166-1 alpha-2 code of the region
+//
+// Returns:
+//
+//   - *Region: the metadata of the region
+//   - bool: true if the region is supported, false otherwise
+func (m *Metadata) Region(code string) (*Region, bool) {
+	if m == nil {
+		return nil, false
+	}
+	region, ok := m.regions[strings.ToUpper(strings.TrimSpace(code))]
+	return region, ok
+}
+
+// Regions returns the sorted codes of the supported regions
+//
+// Returns:
+//
+//   - []string: the codes of the regions
+func (m *Metadata) Regions() []string {
+	if m == nil {
+		return nil
+	}
+	codes := make([]string, 0, len(m.regions))
+	for code := range m.regions {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// RegionsForCallingCode returns the metadata of the regions that share a country calling code, with the main region
+// first
+//
+// Parameters:
+//
+//   - callingCode: the country calling code
+//
+// Returns:
+//
+//   - []*Region: the metadata of the regions
+func (m *Metadata) RegionsForCallingCode(callingCode string) []*Region {
+	if m == nil {
+		return nil
+	}
+	return m.callingCodes[callingCode]
+}
+
+// IsPossibleLength checks if a national significant number has one of the possible lengths of the region
+//
+// Parameters:
+//
+//   - nationalNumber: the national significant number
+//
+// Returns:
+//
+//   - bool: true if the length is possible, false otherwise
+func (r *Region) IsPossibleLength(nationalNumber string) bool {
+	if r == nil {
+		return false
+	}
+	for _, length := range r.Lengths {
+		if len(nationalNumber) == length {
+			return true
+		}
+	}
+	return false
+}
+
+// NumberType returns the type of a national significant number of the region
+//
+// Parameters:
+//
+//   - nationalNumber: the national significant number
+//
+// Returns:
+//
+//   - Type: the type of the number, zero if it does not match any type
+func (r *Region) NumberType(nationalNumber string) Type {
+	if r == nil {
+		return 0
+	}
+	var numberType Type
+	if r.fixedLine.MatchString(nationalNumber) {
+		numberType |= TypeFixedLine
+	}
+	if r.mobile.MatchString(nationalNumber) {
+		numberType |= TypeMobile
+	}
+	return numberType
+}
+
+// lengthBounds returns the minimum and maximum possible lengths of the national significant numbers of the region
+//
+// Returns:
+//
+//   - int: the minimum length
+//   - int: the maximum length
+func (r *Region) lengthBounds() (int, int) {
+	minimum, maximum := r.Lengths[0], r.Lengths[0]
+	for _, length := range r.Lengths[1:] {
+		minimum = min(minimum, length)
+		maximum = max(maximum, length)
+	}
+	return minimum, maximum
+}
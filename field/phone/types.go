@@ -0,0 +1,78 @@
+package phone
+
+type (
+	// Type is a bit set of the phone number types
+	Type uint
+
+	// Options is the phone number validation options struct
+	Options struct {
+		// DefaultRegion is the ISO 3166-1 alpha-2 region used to parse the numbers in the national format, it is
+		// overridden by the region given on each validation, e.g. the one taken from a sibling field
+		DefaultRegion string
+
+		// AllowedRegions are the allowed ISO 3166-1 alpha-2 regions, if empty every supported region is allowed
+		AllowedRegions []string
+
+		// AllowedTypes are the allowed number types, if zero every type is allowed
+		AllowedTypes Type
+
+		// Metadata is the per-region metadata, if nil the bundled metadata is used
+		Metadata *Metadata
+	}
+
+	// Number is a parsed phone number
+	Number struct {
+		// Region is the ISO 3166-1 alpha-2 region of the number
+		Region string
+
+		// CallingCode is the country calling code of the number, e.g. '58'
+		CallingCode string
+
+		// NationalNumber is the national significant number, without the national prefix
+		NationalNumber string
+
+		// Type is the type of the number, zero if it does not match any type of its region
+		Type Type
+	}
+)
+
+const (
+	// TypeFixedLine is the type of the fixed line numbers
+	TypeFixedLine Type = 1 << iota
+
+	// TypeMobile is the type of the mobile numbers
+	TypeMobile
+
+	// TypeFixedLineOrMobile is the type of the numbers that cannot be told apart, e.g. the NANP numbers
+	TypeFixedLineOrMobile = TypeFixedLine | TypeMobile
+)
+
+const (
+	// MaximumE164Length is the maximum number of digits of an E.164 number, including the country calling code
+	MaximumE164Length = 15
+)
+
+// Has checks if the type contains the given types
+//
+// Parameters:
+//
+//   - t: the types to check
+//
+// Returns:
+//
+//   - bool: true if the type contains the given types, false otherwise
+func (t Type) Has(types Type) bool {
+	return t&types == types
+}
+
+// E164 returns the number in the E.164 format, e.g. '+584121234567'
+//
+// Returns:
+//
+//   - string: the formatted number
+func (n *Number) E164() string {
+	if n == nil {
+		return ""
+	}
+	return "+" + n.CallingCode + n.NationalNumber
+}
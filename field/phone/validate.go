@@ -0,0 +1,231 @@
+package phone
+
+import (
+	"strings"
+)
+
+// Parse parses a phone number in the international format, e.g. '+58 412-123-4567', or in the national format of the
+// given region, e.g. '0412-123-4567'
+//
+// Parameters:
+//
+//   - number: the phone number to parse
+//   - region: the ISO 3166-1 alpha-2 region used to parse the numbers in the national format (optional, can be empty)
+//   - metadata: the per-region metadata (optional, if nil the bundled metadata is used)
+//
+// Returns:
+//
+//   - *Number: the parsed phone number
+//   - error: the validation error, if the number could not be parsed or has an impossible length
+func Parse(number, region string, metadata *Metadata) (*Number, error) {
+	if metadata == nil {
+		metadata = DefaultMetadata()
+	}
+
+	// Remove the separators of the number
+	digits, isInternational, ok := extractDigits(number)
+	if !ok {
+		return nil, ErrInvalidFormat
+	}
+
+	// Get the candidate regions of the number
+	var regions []*Region
+	if isInternational {
+		if len(digits) > MaximumE164Length {
+			return nil, ErrTooLong
+		}
+
+		// Country calling codes are prefix-free and have up to 3 digits
+		for length := 1; length <= 3 && length < len(digits); length++ {
+			if regions = metadata.RegionsForCallingCode(digits[:length]); len(regions) > 0 {
+				digits = digits[length:]
+				break
+			}
+		}
+		if len(regions) == 0 {
+			return nil, ErrUnsupportedRegion
+		}
+	} else {
+		if strings.TrimSpace(region) == "" {
+			return nil, ErrMissingRegion
+		}
+		parsedRegion, ok := metadata.Region(region)
+		if !ok {
+			return nil, ErrUnsupportedRegion
+		}
+		regions = []*Region{parsedRegion}
+	}
+
+	// Get the first region whose patterns match the number, the main region is checked last since its patterns are
+	// the broadest, and it is used if none matches
+	candidates := make([]*Region, 0, len(regions))
+	candidates = append(candidates, regions[1:]...)
+	candidates = append(candidates, regions[0])
+	var parsedNumber *Number
+	for _, candidate := range candidates {
+		nationalNumber := stripNationalPrefix(digits, candidate)
+		parsedNumber = &Number{
+			Region:         candidate.Code,
+			CallingCode:    candidate.CallingCode,
+			NationalNumber: nationalNumber,
+			Type:           candidate.NumberType(nationalNumber),
+		}
+		if parsedNumber.Type != 0 {
+			break
+		}
+	}
+
+	// Check the length of the national significant number
+	parsedRegion, _ := metadata.Region(parsedNumber.Region)
+	minimumLength, maximumLength := parsedRegion.lengthBounds()
+	if len(parsedNumber.NationalNumber) < minimumLength {
+		return nil, ErrTooShort
+	}
+	if len(parsedNumber.NationalNumber) > maximumLength ||
+		len(parsedNumber.CallingCode)+len(parsedNumber.NationalNumber) > MaximumE164Length {
+		return nil, ErrTooLong
+	}
+	return parsedNumber, nil
+}
+
+// Validate validates a phone number against its region metadata and the options
+//
+// Parameters:
+//
+//   - number: the phone number to validate
+//   - region: the ISO 3166-1 alpha-2 region used to parse the numbers in the national format, e.g. taken from a
+//     sibling field (optional, if empty the default region of the options is used)
+//   - options: the phone number validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - []error: the validation errors, nil if the phone number is valid
+func Validate(number, region string, options *Options) []error {
+	_, errs := validate(number, region, options)
+	return errs
+}
+
+// Normalize validates a phone number and returns it in the E.164 format
+//
+// Parameters:
+//
+//   - number: the phone number to normalize
+//   - region: the ISO 3166-1 alpha-2 region used to parse the numbers in the national format (optional, if empty the
+//     default region of the options is used)
+//   - options: the phone number validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - string: the phone number in the E.164 format
+//   - error: the first validation error, if the phone number is invalid
+func Normalize(number, region string, options *Options) (string, error) {
+	parsedNumber, errs := validate(number, region, options)
+	if len(errs) > 0 {
+		return "", errs[0]
+	}
+	return parsedNumber.E164(), nil
+}
+
+// validate parses and validates a phone number
+//
+// Parameters:
+//
+//   - number: the phone number to validate
+//   - region: the region used to parse the numbers in the national format (optional, can be empty)
+//   - options: the phone number validation options (optional, can be nil)
+//
+// Returns:
+//
+//   - *Number: the parsed phone number, nil if it could not be parsed
+//   - []error: the validation errors, nil if the phone number is valid
+func validate(number, region string, options *Options) (*Number, []error) {
+	if options == nil {
+		options = &Options{}
+	}
+	if strings.TrimSpace(region) == "" {
+		region = options.DefaultRegion
+	}
+
+	// Parse the phone number
+	parsedNumber, err := Parse(number, region, options.Metadata)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	var errs []error
+
+	// Check if the region is allowed
+	if len(options.AllowedRegions) > 0 {
+		isAllowed := false
+		for _, allowedRegion := range options.AllowedRegions {
+			if strings.EqualFold(strings.TrimSpace(allowedRegion), parsedNumber.Region) {
+				isAllowed = true
+				break
+			}
+		}
+		if !isAllowed {
+			errs = append(errs, ErrRegionNotAllowed)
+		}
+	}
+
+	// Check the number type
+	if parsedNumber.Type == 0 {
+		errs = append(errs, ErrInvalidNumber)
+	} else if options.AllowedTypes != 0 && parsedNumber.Type&options.AllowedTypes == 0 {
+		errs = append(errs, ErrTypeNotAllowed)
+	}
+	return parsedNumber, errs
+}
+
+// extractDigits removes the separators of a phone number
+//
+// Parameters:
+//
+//   - number: the phone number
+//
+// Returns:
+//
+//   - string: the digits of the number
+//   - bool: true if the number is in the international format, false otherwise
+//   - bool: true if the number only contains digits, separators and a leading plus sign, false otherwise
+func extractDigits(number string) (string, bool, bool) {
+	number = strings.TrimSpace(number)
+	isInternational := strings.HasPrefix(number, "+")
+	number = strings.TrimPrefix(number, "+")
+
+	var builder strings.Builder
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			builder.WriteRune(r)
+		case strings.ContainsRune(" -.()/", r):
+		default:
+			return "", false, false
+		}
+	}
+	if builder.Len() == 0 {
+		return "", false, false
+	}
+	return builder.String(), isInternational, true
+}
+
+// stripNationalPrefix removes the national prefix of a number, if the number without it has a possible length
+//
+// Parameters:
+//
+//   - digits: the digits of the number, without the country calling code
+//   - region: the metadata of the region
+//
+// Returns:
+//
+//   - string: the national significant number
+func stripNationalPrefix(digits string, region *Region) string {
+	if region.NationalPrefix == "" || !strings.HasPrefix(digits, region.NationalPrefix) {
+		return digits
+	}
+	stripped := strings.TrimPrefix(digits, region.NationalPrefix)
+	if region.IsPossibleLength(stripped) || !region.IsPossibleLength(digits) {
+		return stripped
+	}
+	return digits
+}
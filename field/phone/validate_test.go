@@ -0,0 +1,161 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		number string
+		region string
+		want   *Number
+		err    error
+	}{
+		{
+			name:   "shared calling code of Canada",
+			number: "+1 (416) 555-0123",
+			want:   &Number{Region: "CA", CallingCode: "1", NationalNumber: "4165550123", Type: TypeFixedLineOrMobile},
+		},
+		{
+			name:   "shared calling code of the main region",
+			number: "+1 212 555 0123",
+			want:   &Number{Region: "US", CallingCode: "1", NationalNumber: "2125550123", Type: TypeFixedLineOrMobile},
+		},
+		{
+			name:   "international",
+			number: "+58 412-123-4567",
+			want:   &Number{Region: "VE", CallingCode: "58", NationalNumber: "4121234567", Type: TypeMobile},
+		},
+		{
+			name:   "international with the national prefix",
+			number: "+58 0412-123-4567",
+			want:   &Number{Region: "VE", CallingCode: "58", NationalNumber: "4121234567", Type: TypeMobile},
+		},
+		{
+			name:   "national prefix stripped",
+			number: "0412.123.4567",
+			region: "ve",
+			want:   &Number{Region: "VE", CallingCode: "58", NationalNumber: "4121234567", Type: TypeMobile},
+		},
+		{
+			name:   "national prefix of the NANP stripped",
+			number: "1 212 555 0123",
+			region: "US",
+			want:   &Number{Region: "US", CallingCode: "1", NationalNumber: "2125550123", Type: TypeFixedLineOrMobile},
+		},
+		{
+			name:   "national prefix kept when the stripped number has an impossible length",
+			number: "0212345678",
+			region: "VE",
+			want:   &Number{Region: "VE", CallingCode: "58", NationalNumber: "0212345678"},
+		},
+		{
+			name:   "leading zero of a region without national prefix",
+			number: "+39 06 1234 5678",
+			want:   &Number{Region: "IT", CallingCode: "39", NationalNumber: "0612345678", Type: TypeFixedLine},
+		},
+		{name: "too short", number: "+58 412 123 456", err: ErrTooShort},
+		{name: "national too short", number: "041212345", region: "VE", err: ErrTooShort},
+		{name: "too long", number: "+58 412 123 45678", err: ErrTooLong},
+		{name: "longer than E.164", number: "+1234567890123456", err: ErrTooLong},
+		{name: "missing region", number: "0412-123-4567", err: ErrMissingRegion},
+		{name: "unsupported region", number: "0412-123-4567", region: "XX", err: ErrUnsupportedRegion},
+		{name: "unsupported calling code", number: "+999 1234 5678", err: ErrUnsupportedRegion},
+		{name: "invalid characters", number: "+58 412 CALL NOW", err: ErrInvalidFormat},
+		{name: "empty", number: "+", err: ErrInvalidFormat},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := Parse(tt.number, tt.region, nil)
+				if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+					t.Fatalf("Parse(%q, %q) = %v, want %v", tt.number, tt.region, err, tt.err)
+				}
+				if tt.want != nil && *got != *tt.want {
+					t.Errorf("Parse(%q, %q) = %+v, want %+v", tt.number, tt.region, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		number  string
+		region  string
+		options *Options
+		want    []error
+	}{
+		{name: "valid", number: "+58 412-123-4567"},
+		{name: "default region", number: "0412-123-4567", options: &Options{DefaultRegion: "VE"}},
+		{
+			name:    "given region overrides the default",
+			number:  "612345678",
+			region:  "ES",
+			options: &Options{DefaultRegion: "VE"},
+		},
+		{name: "invalid for its region", number: "+58 312-123-4567", want: []error{ErrInvalidNumber}},
+		{name: "allowed region", number: "+1 416 555 0123", options: &Options{AllowedRegions: []string{" ca "}}},
+		{
+			name:    "region not allowed",
+			number:  "+1 416 555 0123",
+			options: &Options{AllowedRegions: []string{"US"}},
+			want:    []error{ErrRegionNotAllowed},
+		},
+		{
+			name:    "type not allowed",
+			number:  "+58 212-123-4567",
+			options: &Options{AllowedTypes: TypeMobile},
+			want:    []error{ErrTypeNotAllowed},
+		},
+		{
+			name:    "fixed line or mobile type allowed",
+			number:  "+1 212 555 0123",
+			options: &Options{AllowedTypes: TypeMobile},
+		},
+		{
+			name:    "region not allowed and invalid",
+			number:  "+58 312-123-4567",
+			options: &Options{AllowedRegions: []string{"US"}},
+			want:    []error{ErrRegionNotAllowed, ErrInvalidNumber},
+		},
+		{name: "unparsable", number: "0412-123-4567", want: []error{ErrMissingRegion}},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := Validate(tt.number, tt.region, tt.options)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("Validate(%q) = %v, want %v", tt.number, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("Validate(%q) = %v, want %v", tt.number, errs, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		number  string
+		region  string
+		options *Options
+		want    string
+		err     error
+	}{
+		{number: "+58 (412) 123-4567", want: "+584121234567"},
+		{number: "0412-123-4567", options: &Options{DefaultRegion: "VE"}, want: "+584121234567"},
+		{number: "1 (416) 555-0123", region: "CA", want: "+14165550123"},
+		{number: "+58 312-123-4567", err: ErrInvalidNumber},
+		{number: "+58 412 123 456", err: ErrTooShort},
+	} {
+		got, err := Normalize(tt.number, tt.region, tt.options)
+		if got != tt.want || !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("Normalize(%q, %q) = %q, %v, want %q, %v", tt.number, tt.region, got, err, tt.want, tt.err)
+		}
+	}
+}
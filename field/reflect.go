@@ -0,0 +1,116 @@
+package field
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// StructFieldValue returns the value of a field of a struct, dereferencing the struct and field pointers
+//
+// Parameters:
+//
+//   - structInstance: the struct or struct pointer
+//   - fieldName: the name of the field
+//
+// Returns:
+//
+//   - reflect.Value: the dereferenced value of the field
+//   - bool: true if the field exists and is not nil, false otherwise
+func StructFieldValue(structInstance any, fieldName string) (reflect.Value, bool) {
+	value := reflect.ValueOf(structInstance)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	field := value.FieldByName(fieldName)
+	for field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return reflect.Value{}, false
+		}
+		field = field.Elem()
+	}
+	return field, field.IsValid()
+}
+
+// StringField returns the value of a string or string pointer field of a struct
+//
+// Parameters:
+//
+//   - structInstance: the struct or struct pointer
+//   - fieldName: the name of the field
+//
+// Returns:
+//
+//   - string: the value of the field, empty if the field does not exist, is nil or is not a string
+//   - bool: true if the field exists, is not nil and is a string, false otherwise
+func StringField(structInstance any, fieldName string) (string, bool) {
+	field, ok := StructFieldValue(structInstance, fieldName)
+	if !ok || field.Kind() != reflect.String {
+		return "", false
+	}
+	return field.String(), true
+}
+
+// FloatField returns the value of a numeric or numeric pointer field of a struct as a float
+//
+// Parameters:
+//
+//   - structInstance: the struct or struct pointer
+//   - fieldName: the name of the field
+//
+// Returns:
+//
+//   - float64: the value of the field
+//   - bool: true if the field exists, is not nil and is numeric, false otherwise
+func FloatField(structInstance any, fieldName string) (float64, bool) {
+	field, ok := StructFieldValue(structInstance, fieldName)
+	if !ok {
+		return 0, false
+	}
+
+	switch field.Kind() {
+	case reflect.Float32:
+		// Convert the float from its shortest decimal representation, so its precision is not inflated
+		float, err := strconv.ParseFloat(strconv.FormatFloat(field.Float(), 'f', -1, 32), 64)
+		return float, err == nil
+	case reflect.Float64:
+		return field.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(field.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(field.Uint()), true
+	}
+	return 0, false
+}
+
+// WithDefaultFieldNames returns a copy of a struct of field names, whose empty string fields are set to their own
+// names, e.g. an empty Latitude field is set to 'Latitude'
+//
+// Parameters:
+//
+//   - fieldNames: the struct of field names
+//
+// Returns:
+//
+//   - T: the field names with the defaults
+func WithDefaultFieldNames[T any](fieldNames T) T {
+	value := reflect.ValueOf(&fieldNames).Elem()
+	if value.Kind() != reflect.Struct {
+		return fieldNames
+	}
+
+	valueType := value.Type()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Kind() == reflect.String && field.CanSet() && field.String() == "" {
+			field.SetString(valueType.Field(i).Name)
+		}
+	}
+	return fieldNames
+}
@@ -0,0 +1,111 @@
+package field
+
+import (
+	"sort"
+	"sync"
+)
+
+type (
+	// Rule validates the value of an initialized field, returning its validation errors
+	Rule func(fieldValue any) []error
+
+	// Rules is the registry of the named field rules, which can be referenced from the struct tags
+	Rules struct {
+		mutex sync.RWMutex
+		rules map[string]Rule
+	}
+)
+
+var (
+	// defaultRules is the registry used by the mapper generators to resolve the rules of the struct tags
+	defaultRules     *Rules
+	defaultRulesOnce sync.Once
+)
+
+// NewRules creates a new empty rule registry
+//
+// Returns:
+//
+//   - *Rules: the rule registry
+func NewRules() *Rules {
+	return &Rules{
+		rules: make(map[string]Rule),
+	}
+}
+
+// DefaultRules returns the registry used by the mapper generators to resolve the rules of the struct tags. It is
+// empty until the field packages register their rules on it, e.g. identifier.RegisterRules(field.DefaultRules()).
+// Rules registered on it are shared by the whole process
+//
+// Returns:
+//
+//   - *Rules: the rule registry
+func DefaultRules() *Rules {
+	defaultRulesOnce.Do(
+		func() {
+			defaultRules = NewRules()
+		},
+	)
+	return defaultRules
+}
+
+// Register registers a rule, replacing the previous one with the same name
+//
+// Parameters:
+//
+//   - name: the name of the rule
+//   - rule: the rule
+func (r *Rules) Register(name string, rule Rule) {
+	if r == nil || rule == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Initialize the rules map if it is nil
+	if r.rules == nil {
+		r.rules = make(map[string]Rule)
+	}
+	r.rules[name] = rule
+}
+
+// Lookup returns a registered rule
+//
+// Parameters:
+//
+//   - name: the name of the rule
+//
+// Returns:
+//
+//   - Rule: the rule
+//   - bool: true if the rule is registered, false otherwise
+func (r *Rules) Lookup(name string) (Rule, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	rule, ok := r.rules[name]
+	return rule, ok
+}
+
+// Names returns the sorted names of the registered rules
+//
+// Returns:
+//
+//   - []string: the names of the rules
+func (r *Rules) Names() []string {
+	if r == nil {
+		return nil
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	names := make([]string, 0, len(r.rules))
+	for name := range r.rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,281 @@
+package secrets
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	govalidatorfieldfinance "github.com/ralvarezdev/go-validator/field/finance"
+)
+
+var (
+	// defaultKeyPatterns are the bundled known key formats
+	defaultKeyPatterns = []KeyPattern{
+		{Name: "aws_access_key_id", Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+		{Name: "github_token", Pattern: regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`)},
+		{Name: "github_fine_grained_token", Pattern: regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{22,}\b`)},
+		{Name: "gitlab_token", Pattern: regexp.MustCompile(`\bglpat-[A-Za-z0-9_\-]{20,}`)},
+		{Name: "slack_token", Pattern: regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9\-]{10,}`)},
+		{Name: "stripe_key", Pattern: regexp.MustCompile(`\b(?:sk|rk|pk)_(?:live|test)_[A-Za-z0-9]{16,}\b`)},
+		{Name: "google_api_key", Pattern: regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}`)},
+		{Name: "npm_token", Pattern: regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`)},
+		{Name: "sendgrid_key", Pattern: regexp.MustCompile(`\bSG\.[A-Za-z0-9_\-]{22}\.[A-Za-z0-9_\-]{43}\b`)},
+		{Name: "secret_key", Pattern: regexp.MustCompile(`\bsk-[A-Za-z0-9_\-]{20,}`)},
+		{Name: "jwt", Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]+\.eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`)},
+		{Name: "private_key", Pattern: regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY(?: BLOCK)?-----`)},
+	}
+
+	// emailPattern is the pattern of the email addresses
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`)
+
+	// tokenPattern is the pattern of the candidate high-entropy tokens, made of the base64, base64url and hexadecimal
+	// alphabets
+	tokenPattern = regexp.MustCompile(`[A-Za-z0-9+/=_\-]+`)
+)
+
+// DefaultKeyPatterns returns a copy of the bundled known key formats, which cover AWS, GitHub, GitLab, Slack, Stripe,
+// Google, npm and SendGrid keys, 'sk-' prefixed secret keys, JWTs and PEM private keys
+//
+// Returns:
+//
+//   - []KeyPattern: the key formats
+func DefaultKeyPatterns() []KeyPattern {
+	return append([]KeyPattern(nil), defaultKeyPatterns...)
+}
+
+// Scan finds the sensitive values of a text. The overlapping findings are reported once, preferring the known keys,
+// then the card numbers, the email addresses and the high-entropy tokens
+//
+// Parameters:
+//
+//   - text: the text to scan
+//   - options: the sensitive data detection options (optional, if nil all the detectors are enabled and the
+//     high-entropy tokens are warnings)
+//
+// Returns:
+//
+//   - []Finding: the findings sorted by their offset, nil if there are none
+func Scan(text string, options *Options) []Finding {
+	if options == nil {
+		options = &Options{}
+	}
+	detectors := options.Detectors
+	if detectors == 0 {
+		detectors = DetectorAll
+	}
+	warningDetectors := options.WarningDetectors
+	if warningDetectors == 0 {
+		warningDetectors = DefaultWarningDetectors
+	}
+
+	var findings []Finding
+	addFinding := func(finding Finding) {
+		for _, previousFinding := range findings {
+			if finding.Start < previousFinding.End && previousFinding.Start < finding.End {
+				return
+			}
+		}
+		finding.IsWarning = warningDetectors.Has(finding.Detector)
+		findings = append(findings, finding)
+	}
+
+	// Check the known key formats
+	if detectors.Has(DetectorKey) {
+		keyPatterns := options.KeyPatterns
+		if keyPatterns == nil {
+			keyPatterns = defaultKeyPatterns
+		}
+		for _, keyPattern := range keyPatterns {
+			if keyPattern.Pattern == nil {
+				continue
+			}
+			for _, match := range keyPattern.Pattern.FindAllStringIndex(text, -1) {
+				addFinding(
+					Finding{
+						Detector: DetectorKey,
+						Pattern:  keyPattern.Name,
+						Start:    match[0],
+						End:      match[1],
+						Redacted: redact(text[match[0]:match[1]]),
+					},
+				)
+			}
+		}
+	}
+
+	// Check the card numbers
+	if detectors.Has(DetectorPAN) {
+		for _, match := range govalidatorfieldfinance.FindPANs(text) {
+			addFinding(
+				Finding{
+					Detector: DetectorPAN,
+					Start:    match[0],
+					End:      match[1],
+					Redacted: govalidatorfieldfinance.RedactPAN(text[match[0]:match[1]]),
+				},
+			)
+		}
+	}
+
+	// Check the email addresses
+	if detectors.Has(DetectorEmail) {
+		for _, match := range emailPattern.FindAllStringIndex(text, -1) {
+			addFinding(
+				Finding{
+					Detector: DetectorEmail,
+					Start:    match[0],
+					End:      match[1],
+					Redacted: redactEmail(text[match[0]:match[1]]),
+				},
+			)
+		}
+	}
+
+	// Check the high-entropy tokens
+	if detectors.Has(DetectorHighEntropy) {
+		for _, match := range tokenPattern.FindAllStringIndex(text, -1) {
+			if token := text[match[0]:match[1]]; isHighEntropyToken(token, options) {
+				addFinding(
+					Finding{
+						Detector: DetectorHighEntropy,
+						Start:    match[0],
+						End:      match[1],
+						Redacted: redact(token),
+					},
+				)
+			}
+		}
+	}
+
+	sort.Slice(
+		findings, func(i, j int) bool {
+			return findings[i].Start < findings[j].Start
+		},
+	)
+	return findings
+}
+
+// ShannonEntropy returns the Shannon entropy of a string in bits per character
+//
+// Parameters:
+//
+//   - s: the string
+//
+// Returns:
+//
+//   - float64: the entropy
+func ShannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	// Count the occurrences of each byte
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+
+	entropy := 0.0
+	for _, count := range counts {
+		if count == 0 {
+			continue
+		}
+		probability := float64(count) / float64(len(s))
+		entropy -= probability * math.Log2(probability)
+	}
+	return entropy
+}
+
+// isHighEntropyToken checks if a token looks like a secret, which requires it to be long, to mix letters and digits,
+// and to have a high entropy for its alphabet
+//
+// Parameters:
+//
+//   - token: the token
+//   - options: the sensitive data detection options
+//
+// Returns:
+//
+//   - bool: true if the token looks like a secret, false otherwise
+func isHighEntropyToken(token string, options *Options) bool {
+	minimumTokenLength := options.MinimumTokenLength
+	if minimumTokenLength <= 0 {
+		minimumTokenLength = DefaultMinimumTokenLength
+	}
+	if len(token) < minimumTokenLength {
+		return false
+	}
+
+	// Check the token mixes letters and digits, so words, numbers and identifiers are ignored
+	hasLetter := strings.IndexFunc(token, func(r rune) bool { return (r|0x20) >= 'a' && (r|0x20) <= 'z' }) >= 0
+	hasDigit := strings.IndexFunc(token, func(r rune) bool { return r >= '0' && r <= '9' }) >= 0
+	if !hasLetter || !hasDigit {
+		return false
+	}
+
+	// Check the entropy, with a lower threshold for the hexadecimal tokens
+	minimumEntropy := options.MinimumEntropy
+	if minimumEntropy <= 0 {
+		minimumEntropy = DefaultMinimumEntropy
+	}
+	if isHex(token) {
+		minimumEntropy = options.MinimumHexEntropy
+		if minimumEntropy <= 0 {
+			minimumEntropy = DefaultMinimumHexEntropy
+		}
+	}
+	return ShannonEntropy(token) >= minimumEntropy
+}
+
+// isHex checks if a string only contains hexadecimal digits
+//
+// Parameters:
+//
+//   - s: the string
+//
+// Returns:
+//
+//   - bool: true if the string is hexadecimal, false otherwise
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i] | 0x20
+		if !(s[i] >= '0' && s[i] <= '9') && !(c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// redact masks a secret, keeping its first four characters so its format can still be recognized
+//
+// Parameters:
+//
+//   - secret: the secret
+//
+// Returns:
+//
+//   - string: the redacted secret, e.g. 'AKIA****'
+func redact(secret string) string {
+	if len(secret) <= 8 {
+		return "****"
+	}
+	return secret[:4] + "****"
+}
+
+// redactEmail masks the local part of an email address, keeping its first character and the domain
+//
+// Parameters:
+//
+//   - address: the email address
+//
+// Returns:
+//
+//   - string: the redacted email address, e.g. 'j***@example.com'
+func redactEmail(address string) string {
+	index := strings.LastIndexByte(address, '@')
+	if index <= 0 {
+		return "****"
+	}
+	return address[:1] + "***" + address[index:]
+}
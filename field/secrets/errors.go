@@ -0,0 +1,28 @@
+package secrets
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+var (
+	ErrKey = govalidatorfield.NewError(
+		"secrets.key",
+		"text must not contain API keys, tokens or private keys",
+	)
+	ErrHighEntropy = govalidatorfield.NewError(
+		"secrets.high_entropy",
+		"text must not contain secret-looking tokens",
+	)
+	ErrPAN = govalidatorfield.NewError(
+		"secrets.pan",
+		"text must not contain card numbers",
+	)
+	ErrEmail = govalidatorfield.NewError(
+		"secrets.email",
+		"text must not contain email addresses",
+	)
+	ErrUnsupportedType = govalidatorfield.NewError(
+		"secrets.unsupported_type",
+		"secrets can only be detected in string fields",
+	)
+)
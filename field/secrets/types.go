@@ -0,0 +1,130 @@
+package secrets
+
+import (
+	"regexp"
+)
+
+type (
+	// Detector is the bit set of the sensitive data detectors
+	Detector int
+
+	// KeyPattern is the pattern of a known API key, token or private key format
+	KeyPattern struct {
+		// Name is the name of the key format, e.g. 'aws_access_key_id'
+		Name string
+
+		// Pattern is the pattern of the key
+		Pattern *regexp.Regexp
+	}
+
+	// Finding is a sensitive value found in a text
+	Finding struct {
+		// Detector is the detector that found the value
+		Detector Detector
+
+		// Pattern is the name of the key format, only set for the known keys
+		Pattern string
+
+		// Start and End are the byte offsets of the value in the text
+		Start int
+		End   int
+
+		// Redacted is the redacted value, so the findings can be logged without leaking the value
+		Redacted string
+
+		// IsWarning determines if the finding is reported as a warning instead of a violation
+		IsWarning bool
+	}
+
+	// Options is the sensitive data detection options struct
+	Options struct {
+		// Detectors are the enabled detectors, if zero all of them are enabled
+		Detectors Detector
+
+		// WarningDetectors are the detectors whose findings are reported as warnings to the WarningHandler, which do
+		// not fail the validation. If zero DefaultWarningDetectors is used, set it to DetectorNone to report every
+		// finding as a violation
+		WarningDetectors Detector
+
+		// WarningHandler is called with each warning, e.g. to log it (optional, can be nil)
+		WarningHandler func(finding Finding)
+
+		// KeyPatterns are the known key formats, if nil DefaultKeyPatterns is used
+		KeyPatterns []KeyPattern
+
+		// MinimumTokenLength is the minimum length of the high-entropy tokens, if zero DefaultMinimumTokenLength is used
+		MinimumTokenLength int
+
+		// MinimumEntropy is the minimum Shannon entropy in bits per character of the high-entropy tokens, if zero
+		// DefaultMinimumEntropy is used
+		MinimumEntropy float64
+
+		// MinimumHexEntropy is the minimum entropy of the hexadecimal tokens, whose alphabet is smaller, if zero
+		// DefaultMinimumHexEntropy is used
+		MinimumHexEntropy float64
+	}
+)
+
+const (
+	DetectorKey Detector = 1 << iota
+	DetectorHighEntropy
+	DetectorPAN
+	DetectorEmail
+
+	// DetectorNone is a set without any detector, as the zero value of the sets means their defaults
+	DetectorNone
+	DetectorAll = DetectorKey | DetectorHighEntropy | DetectorPAN | DetectorEmail
+
+	// DefaultWarningDetectors are the default detectors whose findings are warnings, the high-entropy tokens are
+	// heuristic and also match the hashes, e.g. the git commit SHAs, so they do not fail the validation by default
+	DefaultWarningDetectors = DetectorHighEntropy
+)
+
+const (
+	// DefaultMinimumTokenLength is the default minimum length of the high-entropy tokens
+	DefaultMinimumTokenLength = 20
+
+	// DefaultMinimumEntropy is the default minimum entropy of the high-entropy tokens, in bits per character
+	DefaultMinimumEntropy = 4.0
+
+	// DefaultMinimumHexEntropy is the default minimum entropy of the hexadecimal high-entropy tokens
+	DefaultMinimumHexEntropy = 3.0
+
+	// MatchMetadataKey is the metadata key of the redacted value in the validation errors
+	MatchMetadataKey = "match"
+
+	// PatternMetadataKey is the metadata key of the key format name in the validation errors
+	PatternMetadataKey = "pattern"
+)
+
+// Has checks if the detector set contains a detector
+//
+// Parameters:
+//
+//   - detector: the detector to check
+//
+// Returns:
+//
+//   - bool: true if the set contains the detector, false otherwise
+func (d Detector) Has(detector Detector) bool {
+	return d&detector == detector
+}
+
+// String returns the name of the detector
+//
+// Returns:
+//
+//   - string: the name of the detector
+func (d Detector) String() string {
+	switch d {
+	case DetectorKey:
+		return "key"
+	case DetectorHighEntropy:
+		return "high_entropy"
+	case DetectorPAN:
+		return "pan"
+	case DetectorEmail:
+		return "email"
+	}
+	return "unknown"
+}
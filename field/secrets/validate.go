@@ -0,0 +1,77 @@
+package secrets
+
+import (
+	"reflect"
+)
+
+// Validate checks a text for sensitive values, reporting the violations as validation errors and the warnings to
+// the warning handler
+//
+// Parameters:
+//
+//   - text: the text to check
+//   - options: the sensitive data detection options (optional, if nil all the detectors are enabled and the
+//     high-entropy tokens are warnings)
+//
+// Returns:
+//
+//   - []error: the violations, nil if the text has none
+func Validate(text string, options *Options) []error {
+	var errs []error
+	for _, finding := range Scan(text, options) {
+		// Report the warnings to the handler, they do not fail the validation
+		if finding.IsWarning {
+			if options != nil && options.WarningHandler != nil {
+				options.WarningHandler(finding)
+			}
+			continue
+		}
+		errs = append(errs, finding.ValidationError())
+	}
+	return errs
+}
+
+// NewRule creates a field rule that checks a string field for sensitive values, which can be added to each mapper
+// field with its own options, e.g. mapper.AddFieldRule("Notes", secrets.NewRule(options))
+//
+// Parameters:
+//
+//   - options: the sensitive data detection options (optional, if nil all the detectors are enabled and the
+//     high-entropy tokens are warnings)
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func NewRule(options *Options) func(fieldValue any) []error {
+	return func(fieldValue any) []error {
+		// Get the string value of the field, so named string types and pointers are supported
+		reflectedValue := reflect.ValueOf(fieldValue)
+		for reflectedValue.Kind() == reflect.Ptr {
+			if reflectedValue.IsNil() {
+				return nil
+			}
+			reflectedValue = reflectedValue.Elem()
+		}
+		if reflectedValue.Kind() != reflect.String {
+			return []error{ErrUnsupportedType}
+		}
+		return Validate(reflectedValue.String(), options)
+	}
+}
+
+// ValidationError returns the validation error of the finding, with the redacted value in its metadata
+//
+// Returns:
+//
+//   - error: the validation error
+func (f Finding) ValidationError() error {
+	switch f.Detector {
+	case DetectorKey:
+		return ErrKey.WithMetadata(MatchMetadataKey, f.Redacted).WithMetadata(PatternMetadataKey, f.Pattern)
+	case DetectorPAN:
+		return ErrPAN.WithMetadata(MatchMetadataKey, f.Redacted)
+	case DetectorEmail:
+		return ErrEmail.WithMetadata(MatchMetadataKey, f.Redacted)
+	}
+	return ErrHighEntropy.WithMetadata(MatchMetadataKey, f.Redacted)
+}
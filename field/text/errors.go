@@ -0,0 +1,61 @@
+package text
+
+import (
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	ErrTooShortMessage         = "text must have at least %d code points"
+	ErrTooLongMessage          = "text must have at most %d code points"
+	ErrTooFewGraphemesMessage  = "text must have at least %d characters"
+	ErrTooManyGraphemesMessage = "text must have at most %d characters"
+	ErrPatternMismatchMessage  = "text does not match the %s pattern"
+	ErrUnknownPatternMessage   = "text pattern %s is not registered"
+)
+
+var (
+	ErrInvalidEncoding = govalidatorfield.NewError(
+		"text.invalid_encoding",
+		"text is not valid UTF-8",
+	)
+	ErrUnsupportedType = govalidatorfield.NewError(
+		"text.unsupported_type",
+		"text type is not supported",
+	)
+	ErrTooShort = govalidatorfield.NewError(
+		"text.too_short",
+		"text is too short",
+	)
+	ErrTooLong = govalidatorfield.NewError(
+		"text.too_long",
+		"text is too long",
+	)
+	ErrTooFewGraphemes = govalidatorfield.NewError(
+		"text.too_few_graphemes",
+		"text has too few characters",
+	)
+	ErrTooManyGraphemes = govalidatorfield.NewError(
+		"text.too_many_graphemes",
+		"text has too many characters",
+	)
+	ErrControlCharacter = govalidatorfield.NewError(
+		"text.control_character",
+		"text must not contain control characters",
+	)
+	ErrBidiControl = govalidatorfield.NewError(
+		"text.bidi_control",
+		"text must not contain bidirectional override or isolate characters",
+	)
+	ErrNotNormalized = govalidatorfield.NewError(
+		"text.not_normalized",
+		"text must be in Unicode normalization form C",
+	)
+	ErrPatternMismatch = govalidatorfield.NewError(
+		"text.pattern_mismatch",
+		"text does not match the pattern",
+	)
+	ErrUnknownPattern = govalidatorfield.NewError(
+		"text.unknown_pattern",
+		"text pattern is not registered",
+	)
+)
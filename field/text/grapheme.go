@@ -0,0 +1,318 @@
+package text
+
+import (
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type (
+	// graphemeProperty is the grapheme cluster break property of a rune, as defined by UAX #29
+	graphemeProperty int
+
+	// indicConjunctProperty is the Indic_Conjunct_Break property of a rune, as defined by Unicode 15.1
+	indicConjunctProperty int
+)
+
+const (
+	propertyOther graphemeProperty = iota
+	propertyCR
+	propertyLF
+	propertyControl
+	propertyExtend
+	propertyZWJ
+	propertySpacingMark
+	propertyRegionalIndicator
+	propertyL
+	propertyV
+	propertyT
+	propertyLV
+	propertyLVT
+	propertyExtendedPictographic
+	propertyPrepend
+)
+
+const (
+	indicConjunctNone indicConjunctProperty = iota
+	indicConjunctConsonant
+	indicConjunctLinker
+	indicConjunctExtend
+)
+
+var (
+	// extendedPictographic are the main ranges of the Extended_Pictographic property, which cover the emoji
+	extendedPictographic = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x00A9, Hi: 0x00A9, Stride: 1},
+			{Lo: 0x00AE, Hi: 0x00AE, Stride: 1},
+			{Lo: 0x203C, Hi: 0x203C, Stride: 1},
+			{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+			{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+			{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+			{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+			{Lo: 0x21A9, Hi: 0x21AA, Stride: 1},
+			{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+			{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+			{Lo: 0x23CF, Hi: 0x23CF, Stride: 1},
+			{Lo: 0x23E9, Hi: 0x23F3, Stride: 1},
+			{Lo: 0x23F8, Hi: 0x23FA, Stride: 1},
+			{Lo: 0x24C2, Hi: 0x24C2, Stride: 1},
+			{Lo: 0x25AA, Hi: 0x25AB, Stride: 1},
+			{Lo: 0x25B6, Hi: 0x25B6, Stride: 1},
+			{Lo: 0x25C0, Hi: 0x25C0, Stride: 1},
+			{Lo: 0x25FB, Hi: 0x25FE, Stride: 1},
+			{Lo: 0x2600, Hi: 0x27BF, Stride: 1},
+			{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+			{Lo: 0x2B05, Hi: 0x2B07, Stride: 1},
+			{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+			{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+			{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+			{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+			{Lo: 0x303D, Hi: 0x303D, Stride: 1},
+			{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+			{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1F000, Hi: 0x1F1E5, Stride: 1},
+			{Lo: 0x1F200, Hi: 0x1F3FA, Stride: 1},
+			{Lo: 0x1F400, Hi: 0x1FAFF, Stride: 1},
+			{Lo: 0x1FC00, Hi: 0x1FFFD, Stride: 1},
+		},
+	}
+
+	// prepend are the runes of the Prepend property, which do not break from the following rune
+	prepend = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+			{Lo: 0x06DD, Hi: 0x06DD, Stride: 1},
+			{Lo: 0x070F, Hi: 0x070F, Stride: 1},
+			{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+			{Lo: 0x08E2, Hi: 0x08E2, Stride: 1},
+			{Lo: 0x0D4E, Hi: 0x0D4E, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x110BD, Hi: 0x110BD, Stride: 1},
+			{Lo: 0x110CD, Hi: 0x110CD, Stride: 1},
+			{Lo: 0x111C2, Hi: 0x111C3, Stride: 1},
+			{Lo: 0x1193F, Hi: 0x1193F, Stride: 1},
+			{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+			{Lo: 0x11A3A, Hi: 0x11A3A, Stride: 1},
+			{Lo: 0x11A84, Hi: 0x11A89, Stride: 1},
+			{Lo: 0x11D46, Hi: 0x11D46, Stride: 1},
+			{Lo: 0x11F02, Hi: 0x11F02, Stride: 1},
+		},
+	}
+
+	// indicConjunctConsonants are the consonants of the Devanagari, Bengali, Gujarati, Oriya, Telugu and Malayalam
+	// scripts, which form conjuncts when joined by a linker
+	indicConjunctConsonants = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0915, Hi: 0x0939, Stride: 1},
+			{Lo: 0x0958, Hi: 0x095F, Stride: 1},
+			{Lo: 0x0978, Hi: 0x097F, Stride: 1},
+			{Lo: 0x0995, Hi: 0x09A8, Stride: 1},
+			{Lo: 0x09AA, Hi: 0x09B0, Stride: 1},
+			{Lo: 0x09B2, Hi: 0x09B2, Stride: 1},
+			{Lo: 0x09B6, Hi: 0x09B9, Stride: 1},
+			{Lo: 0x09DC, Hi: 0x09DD, Stride: 1},
+			{Lo: 0x09DF, Hi: 0x09DF, Stride: 1},
+			{Lo: 0x09F0, Hi: 0x09F1, Stride: 1},
+			{Lo: 0x0A95, Hi: 0x0AA8, Stride: 1},
+			{Lo: 0x0AAA, Hi: 0x0AB0, Stride: 1},
+			{Lo: 0x0AB2, Hi: 0x0AB3, Stride: 1},
+			{Lo: 0x0AB5, Hi: 0x0AB9, Stride: 1},
+			{Lo: 0x0AF9, Hi: 0x0AF9, Stride: 1},
+			{Lo: 0x0B15, Hi: 0x0B28, Stride: 1},
+			{Lo: 0x0B2A, Hi: 0x0B30, Stride: 1},
+			{Lo: 0x0B32, Hi: 0x0B33, Stride: 1},
+			{Lo: 0x0B35, Hi: 0x0B39, Stride: 1},
+			{Lo: 0x0B5C, Hi: 0x0B5D, Stride: 1},
+			{Lo: 0x0B5F, Hi: 0x0B5F, Stride: 1},
+			{Lo: 0x0B71, Hi: 0x0B71, Stride: 1},
+			{Lo: 0x0C15, Hi: 0x0C28, Stride: 1},
+			{Lo: 0x0C2A, Hi: 0x0C39, Stride: 1},
+			{Lo: 0x0C58, Hi: 0x0C5A, Stride: 1},
+			{Lo: 0x0D15, Hi: 0x0D3A, Stride: 1},
+		},
+	}
+
+	// indicConjunctLinkers are the viramas of the scripts of the Indic conjunct consonants
+	indicConjunctLinkers = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x094D, Hi: 0x094D, Stride: 1},
+			{Lo: 0x09CD, Hi: 0x09CD, Stride: 1},
+			{Lo: 0x0ACD, Hi: 0x0ACD, Stride: 1},
+			{Lo: 0x0B4D, Hi: 0x0B4D, Stride: 1},
+			{Lo: 0x0C4D, Hi: 0x0C4D, Stride: 1},
+			{Lo: 0x0D4D, Hi: 0x0D4D, Stride: 1},
+		},
+	}
+)
+
+// GraphemeCount returns the number of extended grapheme clusters of a string, which are the user-perceived
+// characters, e.g. 'é' written with a combining accent, a flag, a family emoji or the 'क्ष' conjunct count as one. The
+// segmentation follows the extended grapheme cluster rules of UAX #29 for Unicode 15.1, with the Extend, SpacingMark,
+// Control and Extended_Pictographic properties approximated from the general categories of the unicode package
+//
+// Parameters:
+//
+//   - s: the string
+//
+// Returns:
+//
+//   - int: the number of grapheme clusters
+func GraphemeCount(s string) int {
+	count := 0
+	previous := propertyOther
+	regionalIndicators := 0
+	isPictographic, isPictographicZWJ := false, false
+	isConjunctConsonant, isConjunctLinked := false, false
+	for i, r := range s {
+		property := runeGraphemeProperty(r)
+		indicConjunct := runeIndicConjunctProperty(r, property)
+		isIndicConjunct := isConjunctLinked && indicConjunct == indicConjunctConsonant
+		if i == 0 || isGraphemeBreak(previous, property, regionalIndicators, isPictographicZWJ, isIndicConjunct) {
+			count++
+		}
+
+		// Count the consecutive regional indicators, which are paired into flags
+		if property == propertyRegionalIndicator {
+			regionalIndicators++
+		} else {
+			regionalIndicators = 0
+		}
+
+		// Track the emoji sequences joined by a zero width joiner
+		isPictographicZWJ = property == propertyZWJ && isPictographic
+		isPictographic = property == propertyExtendedPictographic || (property == propertyExtend && isPictographic)
+
+		// Track the Indic consonants followed by a linker, which join the next consonant into a conjunct
+		switch {
+		case indicConjunct == indicConjunctConsonant:
+			isConjunctConsonant, isConjunctLinked = true, false
+		case indicConjunct == indicConjunctLinker && isConjunctConsonant:
+			isConjunctLinked = true
+		case indicConjunct != indicConjunctExtend:
+			isConjunctConsonant, isConjunctLinked = false, false
+		}
+		previous = property
+	}
+	return count
+}
+
+// isGraphemeBreak checks if there is a grapheme cluster boundary between two runes
+//
+// Parameters:
+//
+//   - previous: the property of the previous rune
+//   - current: the property of the current rune
+//   - regionalIndicators: the number of consecutive regional indicators before the current rune
+//   - isPictographicZWJ: true if the previous rune is a zero width joiner that follows an emoji
+//   - isIndicConjunct: true if the current rune is an Indic consonant joined by a linker to the previous consonant
+//
+// Returns:
+//
+//   - bool: true if there is a boundary, false otherwise
+func isGraphemeBreak(
+	previous, current graphemeProperty,
+	regionalIndicators int,
+	isPictographicZWJ, isIndicConjunct bool,
+) bool {
+	switch {
+	case previous == propertyCR && current == propertyLF:
+		return false
+	case previous == propertyCR || previous == propertyLF || previous == propertyControl:
+		return true
+	case current == propertyCR || current == propertyLF || current == propertyControl:
+		return true
+	case previous == propertyL && (current == propertyL || current == propertyV || current == propertyLV ||
+		current == propertyLVT):
+		return false
+	case (previous == propertyLV || previous == propertyV) && (current == propertyV || current == propertyT):
+		return false
+	case (previous == propertyLVT || previous == propertyT) && current == propertyT:
+		return false
+	case current == propertyExtend || current == propertyZWJ || current == propertySpacingMark:
+		return false
+	case previous == propertyPrepend:
+		return false
+	case isIndicConjunct:
+		return false
+	case isPictographicZWJ && current == propertyExtendedPictographic:
+		return false
+	case previous == propertyRegionalIndicator && current == propertyRegionalIndicator:
+		return regionalIndicators%2 == 0
+	}
+	return true
+}
+
+// runeGraphemeProperty returns the grapheme cluster break property of a rune
+//
+// Parameters:
+//
+//   - r: the rune
+//
+// Returns:
+//
+//   - graphemeProperty: the property of the rune
+func runeGraphemeProperty(r rune) graphemeProperty {
+	switch {
+	case r == '\r':
+		return propertyCR
+	case r == '\n':
+		return propertyLF
+	case r == 0x200D:
+		return propertyZWJ
+	case r == 0x200C, r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+		return propertyExtend
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return propertyRegionalIndicator
+	case unicode.Is(prepend, r):
+		return propertyPrepend
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return propertyL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return propertyV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return propertyT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return propertyLV
+		}
+		return propertyLVT
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return propertyExtend
+	case unicode.Is(unicode.Mc, r):
+		return propertySpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return propertyControl
+	case unicode.Is(extendedPictographic, r):
+		return propertyExtendedPictographic
+	}
+	return propertyOther
+}
+
+// runeIndicConjunctProperty returns the Indic_Conjunct_Break property of a rune
+//
+// Parameters:
+//
+//   - r: the rune
+//   - property: the grapheme cluster break property of the rune
+//
+// Returns:
+//
+//   - indicConjunctProperty: the property of the rune
+func runeIndicConjunctProperty(r rune, property graphemeProperty) indicConjunctProperty {
+	switch {
+	case unicode.Is(indicConjunctConsonants, r):
+		return indicConjunctConsonant
+	case unicode.Is(indicConjunctLinkers, r):
+		return indicConjunctLinker
+	case property == propertyZWJ:
+		return indicConjunctExtend
+	case property == propertyExtend && norm.NFD.PropertiesString(string(r)).CCC() != 0:
+		// Only the extending marks with a non-zero combining class, e.g. a nukta, keep the conjunct
+		return indicConjunctExtend
+	}
+	return indicConjunctNone
+}
@@ -0,0 +1,66 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGraphemeCount(t *testing.T) {
+	// The cases use the format of the Unicode GraphemeBreakTest.txt file, where '÷' is a boundary and '×' is not
+	for _, tt := range []string{
+		"÷ 0020 ÷ 0020 ÷",
+		"÷ 000D × 000A ÷ 0061 ÷",
+		"÷ 0061 ÷ 000A ÷",
+		"÷ 0061 × 0308 ÷ 0062 ÷",
+		"÷ 0061 × 0903 ÷ 0062 ÷",
+		"÷ 1100 × 1161 × 11A8 ÷ 1100 ÷",
+		"÷ AC00 × 11A8 ÷ 1100 ÷",
+		"÷ AC01 × 11A8 ÷ 1161 ÷",
+		"÷ 1F1E6 × 1F1E7 ÷ 1F1E8 × 1F1E9 ÷ 1F1EA ÷",
+		"÷ 1F468 × 200D × 1F469 × 200D × 1F467 ÷",
+		"÷ 1F44D × 1F3FD ÷",
+		"÷ 0061 × 200D ÷ 1F6D1 ÷",
+		"÷ 0600 × 0661 ÷",
+		"÷ 0600 × 0308 ÷ 0061 ÷",
+		"÷ 0061 ÷ 0600 × 0062 ÷",
+		"÷ 0600 ÷ 000A ÷",
+		"÷ 0915 × 094D × 0937 ÷",
+		"÷ 0915 × 093C × 094D × 0937 ÷",
+		"÷ 0915 × 094D × 200D × 0937 ÷",
+		"÷ 0915 × 094D × 0924 × 094D × 0930 ÷",
+		"÷ 0915 × 094D × 094D × 0937 ÷",
+		"÷ 0995 × 09CD × 09B7 ÷",
+		"÷ 0915 × 094D ÷ 0061 ÷",
+		"÷ 0915 × 0940 ÷ 0937 ÷",
+		"÷ 0915 × 0902 ÷ 0937 ÷",
+		"÷ 0915 ÷ 0937 ÷",
+		"÷ 0061 × 094D ÷ 0937 ÷",
+	} {
+		// Parse the runes and the boundaries before each of them
+		var runes []rune
+		var breaks []bool
+		for i, field := range strings.Fields(tt) {
+			if i%2 == 0 {
+				breaks = append(breaks, field == "÷")
+				continue
+			}
+			r, err := strconv.ParseInt(field, 16, 32)
+			if err != nil {
+				t.Fatalf("strconv.ParseInt(%q) = %v", field, err)
+			}
+			runes = append(runes, rune(r))
+		}
+
+		// Check each boundary through the count of the prefixes, which only increases when a cluster starts
+		want := 0
+		for i := range runes {
+			if breaks[i] {
+				want++
+			}
+			if got := GraphemeCount(string(runes[:i+1])); got != want {
+				t.Errorf("GraphemeCount(%q) = %d, want %d, in %s", string(runes[:i+1]), got, want, tt)
+			}
+		}
+	}
+}
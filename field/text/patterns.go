@@ -0,0 +1,145 @@
+package text
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+)
+
+const (
+	PatternSlug           = "slug"
+	PatternAlpha          = "alpha"
+	PatternAlphanumeric   = "alphanumeric"
+	PatternASCIIPrintable = "ascii_printable"
+	PatternPersonName     = "person_name"
+	PatternHexColor       = "hex_color"
+)
+
+type (
+	// Patterns is the registry of the precompiled RE2 patterns, keyed by name
+	Patterns struct {
+		mutex    sync.RWMutex
+		patterns map[string]*regexp.Regexp
+	}
+)
+
+var (
+	// defaultPatterns is the registry with the bundled patterns
+	defaultPatterns     *Patterns
+	defaultPatternsOnce sync.Once
+)
+
+// NewPatterns creates a new empty pattern registry
+//
+// Returns:
+//
+//   - *Patterns: the pattern registry
+func NewPatterns() *Patterns {
+	return &Patterns{
+		patterns: make(map[string]*regexp.Regexp),
+	}
+}
+
+// DefaultPatterns returns the registry with the bundled patterns, which are slug, alpha, alphanumeric,
+// ascii_printable, person_name and hex_color. Patterns registered on it are shared by the whole process
+//
+// Returns:
+//
+//   - *Patterns: the pattern registry
+func DefaultPatterns() *Patterns {
+	defaultPatternsOnce.Do(
+		func() {
+			defaultPatterns = NewPatterns()
+			defaultPatterns.RegisterRegexp(PatternSlug, regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`))
+			defaultPatterns.RegisterRegexp(PatternAlpha, regexp.MustCompile(`^\p{L}+$`))
+			defaultPatterns.RegisterRegexp(PatternAlphanumeric, regexp.MustCompile(`^[\p{L}\p{N}]+$`))
+			defaultPatterns.RegisterRegexp(PatternASCIIPrintable, regexp.MustCompile(`^[\x20-\x7E]*$`))
+			defaultPatterns.RegisterRegexp(
+				PatternPersonName,
+				regexp.MustCompile(`^\p{L}[\p{L}\p{M}]*\.?(?:[ '’\-][\p{L}][\p{L}\p{M}]*\.?)*$`),
+			)
+			defaultPatterns.RegisterRegexp(PatternHexColor, regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}){1,2}$`))
+		},
+	)
+	return defaultPatterns
+}
+
+// Register compiles a RE2 pattern and registers it, replacing the previous one with the same name
+//
+// Parameters:
+//
+//   - name: the name of the pattern
+//   - pattern: the RE2 pattern, which should be anchored to match the whole text
+//
+// Returns:
+//
+//   - error: if the pattern could not be compiled
+func (p *Patterns) Register(name, pattern string) error {
+	compiledPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	p.RegisterRegexp(name, compiledPattern)
+	return nil
+}
+
+// RegisterRegexp registers a compiled pattern, replacing the previous one with the same name
+//
+// Parameters:
+//
+//   - name: the name of the pattern
+//   - pattern: the compiled pattern
+func (p *Patterns) RegisterRegexp(name string, pattern *regexp.Regexp) {
+	if p == nil || pattern == nil {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Initialize the patterns map if it is nil
+	if p.patterns == nil {
+		p.patterns = make(map[string]*regexp.Regexp)
+	}
+	p.patterns[name] = pattern
+}
+
+// Lookup returns a registered pattern
+//
+// Parameters:
+//
+//   - name: the name of the pattern
+//
+// Returns:
+//
+//   - *regexp.Regexp: the compiled pattern
+//   - bool: true if the pattern is registered, false otherwise
+func (p *Patterns) Lookup(name string) (*regexp.Regexp, bool) {
+	if p == nil {
+		return nil, false
+	}
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	pattern, ok := p.patterns[name]
+	return pattern, ok
+}
+
+// Names returns the sorted names of the registered patterns
+//
+// Returns:
+//
+//   - []string: the names of the patterns
+func (p *Patterns) Names() []string {
+	if p == nil {
+		return nil
+	}
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	names := make([]string, 0, len(p.patterns))
+	for name := range p.patterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
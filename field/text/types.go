@@ -0,0 +1,46 @@
+package text
+
+type (
+	// Options is the free-text policy struct, where the zero limits are not checked
+	Options struct {
+		// MinimumLength is the minimum length in code points
+		MinimumLength int
+
+		// MaximumLength is the maximum length in code points
+		MaximumLength int
+
+		// MinimumGraphemes is the minimum length in user-perceived characters, e.g. an emoji flag counts as one
+		MinimumGraphemes int
+
+		// MaximumGraphemes is the maximum length in user-perceived characters
+		MaximumGraphemes int
+
+		// RejectControlCharacters determines if the C0 and C1 control characters are rejected
+		RejectControlCharacters bool
+
+		// AllowWhitespaceControls determines if the tab, line feed and carriage return are allowed when the control
+		// characters are rejected, e.g. for multi-line bios
+		AllowWhitespaceControls bool
+
+		// RejectBidiControls determines if the bidirectional embedding, override and isolate characters are rejected,
+		// which can be used to spoof the rendered text
+		RejectBidiControls bool
+
+		// RequireNFC determines if the text must be in Unicode normalization form C
+		RequireNFC bool
+
+		// Pattern is the name of the pattern the text must match (optional, can be empty)
+		Pattern string
+
+		// Patterns is the pattern registry, if nil the default registry is used
+		Patterns *Patterns
+	}
+)
+
+const (
+	// LimitMetadataKey is the metadata key of the violated limit in the validation errors
+	LimitMetadataKey = "limit"
+
+	// PatternMetadataKey is the metadata key of the pattern name in the validation errors
+	PatternMetadataKey = "pattern"
+)
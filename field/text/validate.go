@@ -0,0 +1,168 @@
+package text
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+// IsBidiControl checks if a rune is a bidirectional embedding, override or isolate character, from U+202A to U+202E
+// and from U+2066 to U+2069
+//
+// Parameters:
+//
+//   - r: the rune
+//
+// Returns:
+//
+//   - bool: true if the rune is a bidirectional control, false otherwise
+func IsBidiControl(r rune) bool {
+	return (r >= 0x202A && r <= 0x202E) || (r >= 0x2066 && r <= 0x2069)
+}
+
+// Validate validates a free-text value against the free-text policy
+//
+// Parameters:
+//
+//   - text: the text to validate
+//   - options: the free-text policy (optional, if nil only the encoding is checked)
+//
+// Returns:
+//
+//   - []error: the policy violations, nil if the text is valid
+func Validate(text string, options *Options) []error {
+	// Check if the text is valid UTF-8, the other checks are meaningless otherwise
+	if !utf8.ValidString(text) {
+		return []error{ErrInvalidEncoding}
+	}
+	if options == nil {
+		return nil
+	}
+
+	var errs []error
+
+	// Check the length of the text in code points
+	length := utf8.RuneCountInString(text)
+	if options.MinimumLength > 0 && length < options.MinimumLength {
+		errs = append(errs, newLimitError(ErrTooShort, ErrTooShortMessage, options.MinimumLength))
+	}
+	if options.MaximumLength > 0 && length > options.MaximumLength {
+		errs = append(errs, newLimitError(ErrTooLong, ErrTooLongMessage, options.MaximumLength))
+	}
+
+	// Check the length of the text in grapheme clusters
+	if options.MinimumGraphemes > 0 || options.MaximumGraphemes > 0 {
+		graphemes := GraphemeCount(text)
+		if options.MinimumGraphemes > 0 && graphemes < options.MinimumGraphemes {
+			errs = append(
+				errs,
+				newLimitError(ErrTooFewGraphemes, ErrTooFewGraphemesMessage, options.MinimumGraphemes),
+			)
+		}
+		if options.MaximumGraphemes > 0 && graphemes > options.MaximumGraphemes {
+			errs = append(
+				errs,
+				newLimitError(ErrTooManyGraphemes, ErrTooManyGraphemesMessage, options.MaximumGraphemes),
+			)
+		}
+	}
+
+	// Check the control and the bidirectional characters
+	var hasControlCharacter, hasBidiControl bool
+	for _, r := range text {
+		if options.RejectControlCharacters && unicode.IsControl(r) {
+			isWhitespaceControl := r == '\t' || r == '\n' || r == '\r'
+			if !isWhitespaceControl || !options.AllowWhitespaceControls {
+				hasControlCharacter = true
+			}
+		}
+		if options.RejectBidiControls && IsBidiControl(r) {
+			hasBidiControl = true
+		}
+	}
+	if hasControlCharacter {
+		errs = append(errs, ErrControlCharacter)
+	}
+	if hasBidiControl {
+		errs = append(errs, ErrBidiControl)
+	}
+
+	// Check if the text is normalized
+	if options.RequireNFC && !norm.NFC.IsNormalString(text) {
+		errs = append(errs, ErrNotNormalized)
+	}
+
+	// Check if the text matches the pattern
+	if options.Pattern != "" {
+		patterns := options.Patterns
+		if patterns == nil {
+			patterns = DefaultPatterns()
+		}
+		pattern, ok := patterns.Lookup(options.Pattern)
+		switch {
+		case !ok:
+			errs = append(
+				errs,
+				ErrUnknownPattern.
+					WithMessage(fmt.Sprintf(ErrUnknownPatternMessage, options.Pattern)).
+					WithMetadata(PatternMetadataKey, options.Pattern),
+			)
+		case !pattern.MatchString(text):
+			errs = append(
+				errs,
+				ErrPatternMismatch.
+					WithMessage(fmt.Sprintf(ErrPatternMismatchMessage, options.Pattern)).
+					WithMetadata(PatternMetadataKey, options.Pattern),
+			)
+		}
+	}
+	return errs
+}
+
+// NewRule creates a field rule that validates a string field against the free-text policy, which can be added to a
+// mapper field, e.g. mapper.AddFieldRule("Bio", text.NewRule(options))
+//
+// Parameters:
+//
+//   - options: the free-text policy (optional, if nil only the encoding is checked)
+//
+// Returns:
+//
+//   - func(fieldValue any) []error: the field rule
+func NewRule(options *Options) func(fieldValue any) []error {
+	return func(fieldValue any) []error {
+		// Get the string value of the field, so named string types and pointers are supported
+		reflectedValue := reflect.ValueOf(fieldValue)
+		for reflectedValue.Kind() == reflect.Ptr {
+			if reflectedValue.IsNil() {
+				return nil
+			}
+			reflectedValue = reflectedValue.Elem()
+		}
+		if reflectedValue.Kind() != reflect.String {
+			return []error{ErrUnsupportedType}
+		}
+		return Validate(reflectedValue.String(), options)
+	}
+}
+
+// newLimitError creates the validation error of a violated length limit
+//
+// Parameters:
+//
+//   - err: the validation error
+//   - message: the format of the error message
+//   - limit: the violated limit
+//
+// Returns:
+//
+//   - error: the validation error with the limit in its message and metadata
+func newLimitError(err *govalidatorfield.Error, message string, limit int) error {
+	return err.WithMessage(fmt.Sprintf(message, limit)).WithMetadata(LimitMetadataKey, strconv.Itoa(limit))
+}
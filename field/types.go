@@ -0,0 +1,173 @@
+package field
+
+import (
+	"errors"
+)
+
+type (
+	// Error is a field validation error with a machine-readable code
+	Error struct {
+		code     string
+		message  string
+		metadata map[string]string
+	}
+)
+
+// NewError creates a new field validation error
+//
+// Parameters:
+//
+//   - code: the machine-readable code of the error, e.g. 'password.reused'
+//   - message: the human-readable message of the error
+//
+// Returns:
+//
+//   - *Error: the field validation error
+func NewError(code, message string) *Error {
+	return &Error{
+		code:    code,
+		message: message,
+	}
+}
+
+// Error returns the human-readable message of the error
+//
+// Returns:
+//
+//   - string: the message of the error
+func (e *Error) Error() string {
+	if e == nil {
+		return ""
+	}
+	return e.message
+}
+
+// Code returns the machine-readable code of the error
+//
+// Returns:
+//
+//   - string: the code of the error
+func (e *Error) Code() string {
+	if e == nil {
+		return ""
+	}
+	return e.code
+}
+
+// Metadata returns the structured metadata of the error, e.g. a suggested fix
+//
+// Returns:
+//
+//   - map[string]string: the metadata of the error, nil if it has no metadata
+func (e *Error) Metadata() map[string]string {
+	if e == nil {
+		return nil
+	}
+	return e.metadata
+}
+
+// WithMetadata returns a copy of the error with the given metadata key set, so the shared error is not modified
+//
+// Parameters:
+//
+//   - key: the metadata key
+//   - value: the metadata value
+//
+// Returns:
+//
+//   - *Error: the copy of the error with the metadata
+func (e *Error) WithMetadata(key, value string) *Error {
+	if e == nil {
+		return nil
+	}
+
+	// Copy the metadata
+	metadata := make(map[string]string, len(e.metadata)+1)
+	for k, v := range e.metadata {
+		metadata[k] = v
+	}
+	metadata[key] = value
+
+	return &Error{
+		code:     e.code,
+		message:  e.message,
+		metadata: metadata,
+	}
+}
+
+// WithMessage returns a copy of the error with the given message, keeping its code and metadata
+//
+// Parameters:
+//
+//   - message: the human-readable message of the error
+//
+// Returns:
+//
+//   - *Error: the copy of the error with the message
+func (e *Error) WithMessage(message string) *Error {
+	if e == nil {
+		return nil
+	}
+	return &Error{
+		code:     e.code,
+		message:  message,
+		metadata: e.metadata,
+	}
+}
+
+// Is reports whether the target is a field validation error with the same code
+//
+// Parameters:
+//
+//   - target: the error to compare with
+//
+// Returns:
+//
+//   - bool: true if both errors have the same code, false otherwise
+func (e *Error) Is(target error) bool {
+	if e == nil {
+		return false
+	}
+
+	// Check if the target is a field validation error
+	t, ok := target.(*Error)
+	if !ok || t == nil {
+		return false
+	}
+	return e.code == t.code
+}
+
+// GetCode returns the code of the first field validation error in the error chain
+//
+// Parameters:
+//
+//   - err: the error to get the code from
+//
+// Returns:
+//
+//   - string: the code of the error
+//   - bool: true if the error chain contains a field validation error, false otherwise
+func GetCode(err error) (string, bool) {
+	var fieldErr *Error
+	if !errors.As(err, &fieldErr) || fieldErr == nil {
+		return "", false
+	}
+	return fieldErr.code, true
+}
+
+// GetMetadata returns the metadata of the first field validation error in the error chain
+//
+// Parameters:
+//
+//   - err: the error to get the metadata from
+//
+// Returns:
+//
+//   - map[string]string: the metadata of the error, nil if it has no metadata
+func GetMetadata(err error) map[string]string {
+	var fieldErr *Error
+	if !errors.As(err, &fieldErr) || fieldErr == nil {
+		return nil
+	}
+	return fieldErr.metadata
+}
@@ -0,0 +1,207 @@
+package username
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type (
+	// Confusables is the table of the Unicode confusable characters, where each character is mapped to its prototype,
+	// as defined by UTS #39
+	Confusables struct {
+		prototypes map[rune]string
+	}
+)
+
+var (
+	//go:embed data/confusables.txt
+	confusablesData string
+
+	// defaultConfusables is the parsed bundled confusables table
+	defaultConfusables     *Confusables
+	defaultConfusablesOnce sync.Once
+)
+
+// NewConfusables creates a new empty confusables table
+//
+// Returns:
+//
+//   - *Confusables: the confusables table
+func NewConfusables() *Confusables {
+	return &Confusables{
+		prototypes: make(map[rune]string),
+	}
+}
+
+// LoadConfusables loads a confusables table in the format of the Unicode confusables.txt data file, e.g.
+// '0430 ;	0061 ;	MA	# ( а → a )'
+//
+// Parameters:
+//
+//   - reader: the reader of the confusables data
+//
+// Returns:
+//
+//   - *Confusables: the confusables table
+//   - error: if the confusables data could not be read or parsed
+func LoadConfusables(reader io.Reader) (*Confusables, error) {
+	c := NewConfusables()
+
+	// Read the mappings line by line
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		// Remove the byte order mark and the comments
+		line := strings.TrimPrefix(scanner.Text(), "\ufeff")
+		if index := strings.Index(line, "#"); index >= 0 {
+			line = line[:index]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		// Parse the source character and the target sequence
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			return nil, ErrInvalidConfusablesData
+		}
+		source, err := parseCodePoints(fields[0])
+		if err != nil || len([]rune(source)) != 1 {
+			return nil, ErrInvalidConfusablesData
+		}
+		target, err := parseCodePoints(fields[1])
+		if err != nil {
+			return nil, ErrInvalidConfusablesData
+		}
+		c.Add([]rune(source)[0], target)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DefaultConfusables returns the bundled confusables table, which is a subset of the Unicode confusables data that
+// covers the Latin, Cyrillic, Greek and fullwidth lookalikes
+//
+// Returns:
+//
+//   - *Confusables: the confusables table
+func DefaultConfusables() *Confusables {
+	defaultConfusablesOnce.Do(
+		func() {
+			// The bundled data is embedded and well-formed, so it cannot fail to be parsed
+			defaultConfusables, _ = LoadConfusables(strings.NewReader(confusablesData))
+		},
+	)
+	return defaultConfusables
+}
+
+// Add maps a character to its prototype
+//
+// Parameters:
+//
+//   - source: the confusable character
+//   - prototype: the prototype sequence of the character
+func (c *Confusables) Add(source rune, prototype string) {
+	if c == nil {
+		return
+	}
+
+	// Initialize the prototypes map if it is nil
+	if c.prototypes == nil {
+		c.prototypes = make(map[rune]string)
+	}
+	c.prototypes[source] = prototype
+}
+
+// Skeleton returns the UTS #39 skeleton of a string, so two strings are confusable if their skeletons are equal
+//
+// Parameters:
+//
+//   - s: the string
+//
+// Returns:
+//
+//   - string: the skeleton of the string
+func (c *Confusables) Skeleton(s string) string {
+	if c == nil {
+		return norm.NFD.String(s)
+	}
+
+	// Map each character of the decomposed string to its prototype
+	var builder strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if prototype, ok := c.prototypes[r]; ok {
+			builder.WriteString(prototype)
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(builder.String())
+}
+
+// AreConfusable checks if two strings are visually confusable
+//
+// Parameters:
+//
+//   - a: the first string
+//   - b: the second string
+//
+// Returns:
+//
+//   - bool: true if the strings have the same skeleton, false otherwise
+func (c *Confusables) AreConfusable(a, b string) bool {
+	return c.Skeleton(a) == c.Skeleton(b)
+}
+
+// SkeletonKey returns the key of a username that should be enforced as unique to prevent lookalike usernames, which
+// is the skeleton of its normalized form
+//
+// Parameters:
+//
+//   - username: the username
+//   - options: the username policy (optional, can be nil)
+//
+// Returns:
+//
+//   - string: the skeleton key of the username
+func SkeletonKey(username string, options *Options) string {
+	confusables := DefaultConfusables()
+	if options != nil && options.Confusables != nil {
+		confusables = options.Confusables
+	}
+	return confusables.Skeleton(Normalize(username, options))
+}
+
+// parseCodePoints parses a space-separated sequence of hexadecimal code points
+//
+// Parameters:
+//
+//   - s: the code points
+//
+// Returns:
+//
+//   - string: the parsed sequence
+//   - error: if a code point is invalid
+func parseCodePoints(s string) (string, error) {
+	var builder strings.Builder
+	for _, field := range strings.Fields(s) {
+		codePoint, err := strconv.ParseUint(field, 16, 32)
+		if err != nil {
+			return "", err
+		}
+		if codePoint > unicode.MaxRune {
+			return "", ErrInvalidConfusablesData
+		}
+		builder.WriteRune(rune(codePoint))
+	}
+	return builder.String(), nil
+}
@@ -0,0 +1,109 @@
+package username
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSkeleton(t *testing.T) {
+	confusables := DefaultConfusables()
+	for _, tt := range []struct {
+		a    string
+		b    string
+		want bool
+	}{
+		{a: "pаypal", b: "paypal", want: true},
+		{a: "раураl", b: "paypal", want: true},
+		{a: "Αdmin", b: "Admin", want: true},
+		{a: "ａdmin", b: "admin", want: true},
+		{a: "modern", b: "rnodern", want: true},
+		{a: "l1I", b: "lll", want: true},
+		{a: "josé", b: "josé", want: true},
+		{a: "paypal", b: "paypa1", want: true},
+		{a: "paypal", b: "palpay"},
+		{a: "admin", b: "Admin"},
+	} {
+		if got := confusables.AreConfusable(tt.a, tt.b); got != tt.want {
+			t.Errorf(
+				"AreConfusable(%q, %q) = %v, want %v, skeletons %q and %q",
+				tt.a, tt.b, got, tt.want, confusables.Skeleton(tt.a), confusables.Skeleton(tt.b),
+			)
+		}
+	}
+
+	var nilConfusables *Confusables
+	if got := nilConfusables.Skeleton("josé"); got != "josé" {
+		t.Errorf("nil Skeleton(josé) = %q, want the decomposed string", got)
+	}
+}
+
+func TestLoadConfusables(t *testing.T) {
+	confusables, err := LoadConfusables(strings.NewReader("# comment\n\n0430 ;\t0061 ;\tMA\t# a\n"))
+	if err != nil {
+		t.Fatalf("LoadConfusables() = %v", err)
+	}
+	if got := confusables.Skeleton("ае"); got != "aе" {
+		t.Errorf("Skeleton() = %q, want %q", got, "aе")
+	}
+
+	if _, err = LoadConfusables(strings.NewReader("04ZZ ;\t0061 ;\tMA\n")); !errors.Is(err, ErrInvalidConfusablesData) {
+		t.Errorf("LoadConfusables() = %v, want %v", err, ErrInvalidConfusablesData)
+	}
+}
+
+func TestSkeletonKey(t *testing.T) {
+	caseFold := &Options{CaseFold: true}
+	for _, tt := range []struct {
+		a       string
+		b       string
+		options *Options
+		want    bool
+	}{
+		{a: "pаypal", b: "paypal", want: true},
+		{a: "PayPal", b: "paypal", options: caseFold, want: true},
+		{a: "PаyPal", b: "paypal", options: caseFold, want: true},
+		{a: "PayPal", b: "paypal"},
+		{
+			a:       "pаypal",
+			b:       "paypal",
+			options: &Options{Confusables: NewConfusables()},
+		},
+	} {
+		if got := SkeletonKey(tt.a, tt.options) == SkeletonKey(tt.b, tt.options); got != tt.want {
+			t.Errorf("SkeletonKey(%q) == SkeletonKey(%q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestValidateConfusableWithReserved(t *testing.T) {
+	options := &Options{
+		AllowedClasses:    ClassUnicodeAlphanumeric,
+		ReservedNames:     DefaultReservedNames(),
+		Confusables:       DefaultConfusables(),
+		RejectMixedScript: true,
+	}
+	for _, tt := range []struct {
+		username string
+		want     []error
+	}{
+		{username: "аdmin", want: []error{ErrMixedScript, ErrConfusableWithReserved}},
+		{username: "аԁmіn", want: []error{ErrMixedScript, ErrConfusableWithReserved}},
+		{username: "rооt", want: []error{ErrMixedScript, ErrConfusableWithReserved}},
+		{username: "adrnin", want: []error{ErrConfusableWithReserved}},
+		{username: "adm1n"},
+		{username: "admin", want: []error{ErrReserved}},
+		{username: "админ"},
+		{username: "alice"},
+	} {
+		errs := Validate(tt.username, options)
+		if len(errs) != len(tt.want) {
+			t.Fatalf("Validate(%q) = %v, want %v", tt.username, errs, tt.want)
+		}
+		for i := range errs {
+			if !errors.Is(errs[i], tt.want[i]) {
+				t.Errorf("Validate(%q) = %v, want %v", tt.username, errs, tt.want)
+			}
+		}
+	}
+}
@@ -2,8 +2,55 @@ package username
 
 import (
 	"errors"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	ErrTooShortMessage = "username must have at least %d characters"
+	ErrTooLongMessage  = "username must have at most %d characters"
+)
+
+var (
+	ErrMustBeAlphanumeric     = errors.New("username must only have alphanumeric characters")
+	ErrInvalidConfusablesData = errors.New("invalid confusables data")
 )
 
 var (
-	ErrMustBeAlphanumeric = errors.New("username must be have alphanumeric characters")
+	ErrTooShort = govalidatorfield.NewError(
+		"username.too_short",
+		"username is too short",
+	)
+	ErrTooLong = govalidatorfield.NewError(
+		"username.too_long",
+		"username is too long",
+	)
+	ErrInvalidCharacter = govalidatorfield.NewError(
+		"username.invalid_character",
+		"username contains characters that are not allowed",
+	)
+	ErrLeadingSeparator = govalidatorfield.NewError(
+		"username.leading_separator",
+		"username must not start with a separator",
+	)
+	ErrTrailingSeparator = govalidatorfield.NewError(
+		"username.trailing_separator",
+		"username must not end with a separator",
+	)
+	ErrConsecutiveSeparators = govalidatorfield.NewError(
+		"username.consecutive_separators",
+		"username must not contain consecutive separators",
+	)
+	ErrReserved = govalidatorfield.NewError(
+		"username.reserved",
+		"username is reserved",
+	)
+	ErrMixedScript = govalidatorfield.NewError(
+		"username.mixed_script",
+		"username must not mix characters from different scripts",
+	)
+	ErrConfusableWithReserved = govalidatorfield.NewError(
+		"username.confusable_with_reserved",
+		"username looks like a reserved name",
+	)
 )
@@ -0,0 +1,150 @@
+package username
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strings"
+	"sync"
+)
+
+type (
+	// ReservedNames is a set of names that cannot be used as usernames, matched case-insensitively
+	ReservedNames struct {
+		names map[string]struct{}
+	}
+)
+
+var (
+	//go:embed data/reserved_names.txt
+	reservedNamesData string
+
+	// defaultReservedNames is the parsed bundled reserved names list
+	defaultReservedNames     *ReservedNames
+	defaultReservedNamesOnce sync.Once
+)
+
+// NewReservedNames creates a new reserved names set
+//
+// Parameters:
+//
+//   - names: the reserved names
+//
+// Returns:
+//
+//   - *ReservedNames: the reserved names set
+func NewReservedNames(names ...string) *ReservedNames {
+	r := &ReservedNames{
+		names: make(map[string]struct{}, len(names)),
+	}
+	r.Add(names...)
+	return r
+}
+
+// LoadReservedNames loads a reserved names set with one name per line, empty lines and lines starting with '#' are
+// ignored
+//
+// Parameters:
+//
+//   - reader: the reader of the reserved names
+//
+// Returns:
+//
+//   - *ReservedNames: the reserved names set
+//   - error: if the reserved names could not be read
+func LoadReservedNames(reader io.Reader) (*ReservedNames, error) {
+	r := NewReservedNames()
+
+	// Read the names line by line
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		r.Add(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// DefaultReservedNames returns the bundled reserved names set
+//
+// Returns:
+//
+//   - *ReservedNames: the reserved names set
+func DefaultReservedNames() *ReservedNames {
+	defaultReservedNamesOnce.Do(
+		func() {
+			// The bundled list is embedded, so it cannot fail to be read
+			defaultReservedNames, _ = LoadReservedNames(strings.NewReader(reservedNamesData))
+		},
+	)
+	return defaultReservedNames
+}
+
+// Add adds names to the set
+//
+// Parameters:
+//
+//   - names: the names to add
+func (r *ReservedNames) Add(names ...string) {
+	if r == nil {
+		return
+	}
+
+	// Initialize the names map if it is nil
+	if r.names == nil {
+		r.names = make(map[string]struct{}, len(names))
+	}
+
+	for _, name := range names {
+		if name = foldCase(strings.TrimSpace(name)); name != "" {
+			r.names[name] = struct{}{}
+		}
+	}
+}
+
+// Contains checks if the name is reserved
+//
+// Parameters:
+//
+//   - name: the name to check
+//
+// Returns:
+//
+//   - bool: true if the name is reserved, false otherwise
+func (r *ReservedNames) Contains(name string) bool {
+	if r == nil || len(r.names) == 0 {
+		return false
+	}
+	_, ok := r.names[foldCase(name)]
+	return ok
+}
+
+// ContainsConfusable checks if the name looks like any reserved name
+//
+// Parameters:
+//
+//   - name: the name to check
+//   - confusables: the confusables table used to compare the names
+//
+// Returns:
+//
+//   - bool: true if the name is confusable with a reserved name, false otherwise
+func (r *ReservedNames) ContainsConfusable(name string, confusables *Confusables) bool {
+	if r == nil || len(r.names) == 0 {
+		return false
+	}
+
+	// Compare the skeleton of the name with the skeleton of each reserved name
+	skeleton := confusables.Skeleton(foldCase(name))
+	for reservedName := range r.names {
+		if confusables.Skeleton(reservedName) == skeleton {
+			return true
+		}
+	}
+	return false
+}
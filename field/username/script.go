@@ -0,0 +1,103 @@
+package username
+
+import (
+	"sort"
+	"unicode"
+)
+
+var (
+	// allowedScriptCombinations are the scripts that can be mixed in a single identifier, following the highly
+	// restrictive profile of UTS #39
+	allowedScriptCombinations = []map[string]struct{}{
+		{"Latin": {}, "Han": {}, "Hiragana": {}, "Katakana": {}},
+		{"Latin": {}, "Han": {}, "Bopomofo": {}},
+		{"Latin": {}, "Han": {}, "Hangul": {}},
+	}
+)
+
+// Scripts returns the sorted Unicode scripts of the characters of a string, ignoring the Common and Inherited scripts
+//
+// Parameters:
+//
+//   - s: the string
+//
+// Returns:
+//
+//   - []string: the names of the scripts
+func Scripts(s string) []string {
+	scripts := make(map[string]struct{})
+	for _, r := range s {
+		if script := runeScript(r); script != "" {
+			scripts[script] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(scripts))
+	for name := range scripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsMixedScript checks if a string mixes scripts in a way that is not allowed by the highly restrictive profile of
+// UTS #39, e.g. Latin and Cyrillic letters
+//
+// Parameters:
+//
+//   - s: the string
+//
+// Returns:
+//
+//   - bool: true if the string mixes scripts, false otherwise
+func IsMixedScript(s string) bool {
+	scripts := Scripts(s)
+	if len(scripts) <= 1 {
+		return false
+	}
+
+	// Check if the scripts are a subset of any of the allowed combinations
+	for _, combination := range allowedScriptCombinations {
+		isSubset := true
+		for _, script := range scripts {
+			if _, ok := combination[script]; !ok {
+				isSubset = false
+				break
+			}
+		}
+		if isSubset {
+			return false
+		}
+	}
+	return true
+}
+
+// runeScript returns the Unicode script of a rune
+//
+// Parameters:
+//
+//   - r: the rune
+//
+// Returns:
+//
+//   - string: the name of the script, empty if the rune belongs to the Common or Inherited scripts
+func runeScript(r rune) string {
+	// Check the most common scripts first
+	switch {
+	case unicode.In(r, unicode.Common, unicode.Inherited):
+		return ""
+	case unicode.Is(unicode.Latin, r):
+		return "Latin"
+	case unicode.Is(unicode.Cyrillic, r):
+		return "Cyrillic"
+	case unicode.Is(unicode.Greek, r):
+		return "Greek"
+	}
+
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
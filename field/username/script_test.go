@@ -0,0 +1,50 @@
+package username
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestScripts(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want []string
+	}{
+		{s: "paypal", want: []string{"Latin"}},
+		{s: "pаypal", want: []string{"Cyrillic", "Latin"}},
+		{s: "user_123", want: []string{"Latin"}},
+		{s: "123-_.", want: []string{}},
+		{s: "josé", want: []string{"Latin"}},
+		{s: "αβγ", want: []string{"Greek"}},
+		{s: "山田たろう", want: []string{"Han", "Hiragana"}},
+	} {
+		if got := Scripts(tt.s); !slices.Equal(got, tt.want) {
+			t.Errorf("Scripts(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIsMixedScript(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want bool
+	}{
+		{s: "paypal"},
+		{s: "павел"},
+		{s: "user123"},
+		{s: "josé"},
+		{s: "tokyo東京トーキョー"},
+		{s: "seoul\uc11c울山"},
+		{s: "taipei台北ㄅ"},
+		{s: "pаypal", want: true},
+		{s: "аdmin", want: true},
+		{s: "αlpha", want: true},
+		{s: "аα", want: true},
+		{s: "한カ", want: true},
+		{s: "العربيةabc", want: true},
+	} {
+		if got := IsMixedScript(tt.s); got != tt.want {
+			t.Errorf("IsMixedScript(%q) = %v, want %v, scripts %q", tt.s, got, tt.want, Scripts(tt.s))
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package username
+
+type (
+	// CharacterClass is a bit set of the character classes allowed in a username
+	CharacterClass uint
+
+	// Options is the username policy struct
+	Options struct {
+		// MinimumLength is the minimum number of characters (runes) of the username, if zero it is not checked
+		MinimumLength int
+
+		// MaximumLength is the maximum number of characters (runes) of the username, if zero it is not checked
+		MaximumLength int
+
+		// AllowedClasses is the set of character classes allowed, if zero ClassASCIIAlphanumeric is used
+		AllowedClasses CharacterClass
+
+		// Separators are the separator characters allowed between the other characters, e.g. "_.-"
+		Separators string
+
+		// AllowLeadingSeparator allows the username to start with a separator
+		AllowLeadingSeparator bool
+
+		// AllowTrailingSeparator allows the username to end with a separator
+		AllowTrailingSeparator bool
+
+		// AllowConsecutiveSeparators allows two or more separators to appear next to each other
+		AllowConsecutiveSeparators bool
+
+		// CaseFold makes the usernames case-insensitive, so they are folded before being validated
+		CaseFold bool
+
+		// ReservedNames are the names that cannot be used, matched case-insensitively (optional, can be nil)
+		ReservedNames *ReservedNames
+
+		// RejectMixedScript rejects the usernames that mix scripts, e.g. Latin and Cyrillic letters
+		RejectMixedScript bool
+
+		// Confusables is used to reject the usernames that look like a reserved name, and to compute the skeleton
+		// keys (optional, can be nil)
+		Confusables *Confusables
+	}
+)
+
+const (
+	// ClassASCIILowercase allows the ASCII lowercase letters
+	ClassASCIILowercase CharacterClass = 1 << iota
+
+	// ClassASCIIUppercase allows the ASCII uppercase letters
+	ClassASCIIUppercase
+
+	// ClassASCIIDigits allows the ASCII digits
+	ClassASCIIDigits
+
+	// ClassUnicodeLetters allows any Unicode letter, including the ASCII ones
+	ClassUnicodeLetters
+
+	// ClassUnicodeDigits allows any Unicode decimal digit, including the ASCII ones
+	ClassUnicodeDigits
+
+	// ClassUnicodeMarks allows the Unicode combining marks, required by some scripts
+	ClassUnicodeMarks
+
+	// ClassASCIIAlphanumeric allows the ASCII letters and digits
+	ClassASCIIAlphanumeric = ClassASCIILowercase | ClassASCIIUppercase | ClassASCIIDigits
+
+	// ClassUnicodeAlphanumeric allows any Unicode letter, digit and combining mark
+	ClassUnicodeAlphanumeric = ClassUnicodeLetters | ClassUnicodeDigits | ClassUnicodeMarks
+)
+
+// Has checks if the character classes contain the given classes
+//
+// Parameters:
+//
+//   - class: the classes to check
+//
+// Returns:
+//
+//   - bool: true if every given class is allowed, false otherwise
+func (c CharacterClass) Has(class CharacterClass) bool {
+	return c&class == class
+}
@@ -0,0 +1,181 @@
+package username
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	gostringscount "github.com/ralvarezdev/go-strings/count"
+	"golang.org/x/text/cases"
+)
+
+// foldCase returns the Unicode case folded form of a string
+//
+// Parameters:
+//
+//   - s: the string to fold
+//
+// Returns:
+//
+//   - string: the case folded string
+func foldCase(s string) string {
+	return cases.Fold().String(s)
+}
+
+// Normalize returns the form of the username that should be stored and compared, which is the case folded username
+// when the policy is case-insensitive
+//
+// Parameters:
+//
+//   - username: the username to normalize
+//   - options: the username policy (optional, can be nil)
+//
+// Returns:
+//
+//   - string: the normalized username
+func Normalize(username string, options *Options) string {
+	if options == nil || !options.CaseFold {
+		return username
+	}
+	return foldCase(username)
+}
+
+// Validate validates a username against the username policy
+//
+// Parameters:
+//
+//   - username: the username to validate
+//   - options: the username policy (optional, if nil the username must only contain alphanumeric characters)
+//
+// Returns:
+//
+//   - []error: the policy violations, nil if the username is valid
+func Validate(username string, options *Options) []error {
+	// Check if the username contains non-alphanumeric characters
+	if options == nil {
+		if gostringscount.Alphanumeric(username) != utf8.RuneCountInString(username) {
+			return []error{ErrMustBeAlphanumeric}
+		}
+		return nil
+	}
+
+	// Fold the username, if the policy is case-insensitive
+	username = Normalize(username, options)
+
+	var errs []error
+
+	// Check the length of the username in runes
+	length := utf8.RuneCountInString(username)
+	if options.MinimumLength > 0 && length < options.MinimumLength {
+		errs = append(
+			errs,
+			ErrTooShort.WithMessage(fmt.Sprintf(ErrTooShortMessage, options.MinimumLength)),
+		)
+	}
+	if options.MaximumLength > 0 && length > options.MaximumLength {
+		errs = append(
+			errs,
+			ErrTooLong.WithMessage(fmt.Sprintf(ErrTooLongMessage, options.MaximumLength)),
+		)
+	}
+
+	// Check the characters and the separators of the username
+	errs = append(errs, validateCharacters(username, options)...)
+
+	// Check if the username mixes scripts
+	if options.RejectMixedScript && IsMixedScript(username) {
+		errs = append(errs, ErrMixedScript)
+	}
+
+	// Check if the username is reserved or looks like a reserved name
+	if options.ReservedNames.Contains(username) {
+		errs = append(errs, ErrReserved)
+	} else if options.Confusables != nil && options.ReservedNames.ContainsConfusable(
+		username,
+		options.Confusables,
+	) {
+		errs = append(errs, ErrConfusableWithReserved)
+	}
+	return errs
+}
+
+// validateCharacters checks that the username only contains allowed characters and separators, and that the separators
+// are in allowed positions
+//
+// Parameters:
+//
+//   - username: the username to check
+//   - options: the username policy
+//
+// Returns:
+//
+//   - []error: the policy violations, nil if the characters are valid
+func validateCharacters(username string, options *Options) []error {
+	allowedClasses := options.AllowedClasses
+	if allowedClasses == 0 {
+		allowedClasses = ClassASCIIAlphanumeric
+	}
+
+	var errs []error
+	var hasInvalidCharacter, hasConsecutiveSeparators bool
+	isFirst, isPreviousSeparator := true, false
+	for _, r := range username {
+		isSeparator := options.Separators != "" && strings.ContainsRune(options.Separators, r)
+		switch {
+		case isSeparator:
+			// Check if the separator is at the start of the username
+			if isFirst && !options.AllowLeadingSeparator {
+				errs = append(errs, ErrLeadingSeparator)
+			}
+
+			// Check if the separator follows another one
+			if isPreviousSeparator && !options.AllowConsecutiveSeparators {
+				hasConsecutiveSeparators = true
+			}
+		case !isAllowedRune(r, allowedClasses):
+			hasInvalidCharacter = true
+		}
+		isFirst, isPreviousSeparator = false, isSeparator
+	}
+
+	// Check if the username ends with a separator
+	if isPreviousSeparator && !options.AllowTrailingSeparator {
+		errs = append(errs, ErrTrailingSeparator)
+	}
+	if hasConsecutiveSeparators {
+		errs = append(errs, ErrConsecutiveSeparators)
+	}
+	if hasInvalidCharacter {
+		errs = append(errs, ErrInvalidCharacter)
+	}
+	return errs
+}
+
+// isAllowedRune checks if the rune belongs to any of the allowed character classes
+//
+// Parameters:
+//
+//   - r: the rune to check
+//   - classes: the allowed character classes
+//
+// Returns:
+//
+//   - bool: true if the rune is allowed, false otherwise
+func isAllowedRune(r rune, classes CharacterClass) bool {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return classes.Has(ClassASCIILowercase) || classes.Has(ClassUnicodeLetters)
+	case r >= 'A' && r <= 'Z':
+		return classes.Has(ClassASCIIUppercase) || classes.Has(ClassUnicodeLetters)
+	case r >= '0' && r <= '9':
+		return classes.Has(ClassASCIIDigits) || classes.Has(ClassUnicodeDigits)
+	case unicode.IsLetter(r):
+		return classes.Has(ClassUnicodeLetters)
+	case unicode.IsDigit(r):
+		return classes.Has(ClassUnicodeDigits)
+	case unicode.IsMark(r):
+		return classes.Has(ClassUnicodeMarks)
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package username
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	separators := &Options{Separators: "_."}
+	for _, tt := range []struct {
+		name     string
+		username string
+		options  *Options
+		want     []error
+	}{
+		{name: "alphanumeric without policy", username: "john123"},
+		{name: "separator without policy", username: "john_doe", want: []error{ErrMustBeAlphanumeric}},
+		{name: "minimum length", username: "abc", options: &Options{MinimumLength: 3}},
+		{name: "too short", username: "ab", options: &Options{MinimumLength: 3}, want: []error{ErrTooShort}},
+		{name: "maximum length", username: "abcd", options: &Options{MaximumLength: 4}},
+		{name: "too long", username: "abcde", options: &Options{MaximumLength: 4}, want: []error{ErrTooLong}},
+		{
+			name:     "length in runes",
+			username: "josé",
+			options:  &Options{MaximumLength: 4, AllowedClasses: ClassUnicodeAlphanumeric},
+		},
+		{name: "separator", username: "john_doe.dev", options: separators},
+		{name: "separator not allowed", username: "john-doe", options: separators, want: []error{ErrInvalidCharacter}},
+		{name: "leading separator", username: "_john", options: separators, want: []error{ErrLeadingSeparator}},
+		{
+			name:     "leading separator allowed",
+			username: "_john",
+			options:  &Options{Separators: "_", AllowLeadingSeparator: true},
+		},
+		{name: "trailing separator", username: "john.", options: separators, want: []error{ErrTrailingSeparator}},
+		{
+			name:     "trailing separator allowed",
+			username: "john.",
+			options:  &Options{Separators: ".", AllowTrailingSeparator: true},
+		},
+		{
+			name:     "consecutive separators",
+			username: "john._doe",
+			options:  separators,
+			want:     []error{ErrConsecutiveSeparators},
+		},
+		{
+			name:     "consecutive separators allowed",
+			username: "john__doe",
+			options:  &Options{Separators: "_", AllowConsecutiveSeparators: true},
+		},
+		{
+			name:     "only separators",
+			username: "__",
+			options:  separators,
+			want:     []error{ErrLeadingSeparator, ErrTrailingSeparator, ErrConsecutiveSeparators},
+		},
+		{name: "non-ASCII letter", username: "josé", options: &Options{}, want: []error{ErrInvalidCharacter}},
+		{name: "Unicode letter", username: "josé", options: &Options{AllowedClasses: ClassUnicodeLetters}},
+		{
+			name:     "uppercase not allowed",
+			username: "John",
+			options:  &Options{AllowedClasses: ClassASCIILowercase},
+			want:     []error{ErrInvalidCharacter},
+		},
+		{
+			name:     "uppercase folded",
+			username: "John",
+			options:  &Options{AllowedClasses: ClassASCIILowercase, CaseFold: true},
+		},
+		{
+			name:     "digit not allowed",
+			username: "john1",
+			options:  &Options{AllowedClasses: ClassASCIILowercase},
+			want:     []error{ErrInvalidCharacter},
+		},
+		{name: "Unicode digit", username: "user٣", options: &Options{AllowedClasses: ClassUnicodeAlphanumeric}},
+		{name: "non-ASCII digit", username: "user٣", options: &Options{}, want: []error{ErrInvalidCharacter}},
+		{
+			name:     "combining mark not allowed",
+			username: "jose\u0301",
+			options:  &Options{AllowedClasses: ClassUnicodeLetters},
+			want:     []error{ErrInvalidCharacter},
+		},
+		{
+			name:     "combining mark",
+			username: "jose\u0301",
+			options:  &Options{AllowedClasses: ClassUnicodeAlphanumeric},
+		},
+		{
+			name:     "reserved",
+			username: "Admin",
+			options:  &Options{ReservedNames: DefaultReservedNames(), CaseFold: true},
+			want:     []error{ErrReserved},
+		},
+		{
+			name:     "reserved without case folding",
+			username: "ROOT",
+			options:  &Options{ReservedNames: DefaultReservedNames()},
+			want:     []error{ErrReserved},
+		},
+		{name: "not reserved", username: "admin1", options: &Options{ReservedNames: DefaultReservedNames()}},
+		{
+			name:     "custom reserved",
+			username: "Staff",
+			options:  &Options{ReservedNames: NewReservedNames("staff")},
+			want:     []error{ErrReserved},
+		},
+		{
+			name:     "several violations",
+			username: "_A",
+			options:  &Options{MinimumLength: 3, Separators: "_", AllowedClasses: ClassASCIILowercase},
+			want:     []error{ErrTooShort, ErrLeadingSeparator, ErrInvalidCharacter},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				errs := Validate(tt.username, tt.options)
+				if len(errs) != len(tt.want) {
+					t.Fatalf("Validate(%q) = %v, want %v", tt.username, errs, tt.want)
+				}
+				for i := range errs {
+					if !errors.Is(errs[i], tt.want[i]) {
+						t.Errorf("Validate(%q) = %v, want %v", tt.username, errs, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		username string
+		options  *Options
+		want     string
+	}{
+		{username: "John", want: "John"},
+		{username: "John", options: &Options{}, want: "John"},
+		{username: "John", options: &Options{CaseFold: true}, want: "john"},
+		{username: "Straße", options: &Options{CaseFold: true}, want: "strasse"},
+	} {
+		if got := Normalize(tt.username, tt.options); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.username, got, tt.want)
+		}
+	}
+}
+
+func TestReservedNames(t *testing.T) {
+	reservedNames := NewReservedNames(" Support ", "")
+	for _, tt := range []struct {
+		name string
+		want bool
+	}{
+		{name: "support", want: true},
+		{name: "SUPPORT", want: true},
+		{name: "supporter"},
+		{name: ""},
+	} {
+		if got := reservedNames.Contains(tt.name); got != tt.want {
+			t.Errorf("Contains(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	var nilReservedNames *ReservedNames
+	if nilReservedNames.Contains("admin") {
+		t.Errorf("nil Contains(admin) = true, want false")
+	}
+}
@@ -5,7 +5,11 @@ go 1.24.0
 require (
 	github.com/ralvarezdev/go-reflect v0.3.1
 	github.com/ralvarezdev/go-strings v0.2.2
+	golang.org/x/crypto v0.44.0
+	golang.org/x/net v0.47.0
+	golang.org/x/text v0.31.0
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251029180050-ab9386a59fda
+	google.golang.org/protobuf v1.36.10
 )
 
-require google.golang.org/protobuf v1.36.10 // indirect
+require golang.org/x/sys v0.38.0 // indirect
@@ -4,9 +4,14 @@ import (
 	"errors"
 )
 
+const (
+	ErrUnknownFieldRule = "unknown rule %s in the rules tag of the field: %s"
+)
+
 var (
 	ErrNilGenerator            = errors.New("generator cannot be nil")
 	ErrNilMapper               = errors.New("mapper cannot be nil")
+	ErrNilStructField          = errors.New("struct field cannot be nil")
 	ErrNilStructInstance       = errors.New("struct instance cannot be nil")
 	ErrStructInstanceNotStruct = errors.New("struct instance must be a struct")
 	ErrInvalidStructInstance   = errors.New("invalid struct instance")
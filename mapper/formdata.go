@@ -0,0 +1,256 @@
+package mapper
+
+import (
+	"log/slog"
+	"mime/multipart"
+	"reflect"
+	"strings"
+
+	goreflect "github.com/ralvarezdev/go-reflect"
+)
+
+const (
+	// FormDataTag is the struct tag of the form-data fields, e.g. `form:"avatar,omitempty"`
+	FormDataTag = "form"
+
+	// FormDataOmitempty is the form-data tag option of the optional fields
+	FormDataOmitempty = "omitempty"
+)
+
+var (
+	// fileHeaderType is the type of the uploaded multipart files, which are not nested structs
+	fileHeaderType = reflect.TypeOf(multipart.FileHeader{})
+)
+
+type (
+	// FormDataGenerator is a generator for form-data mappers
+	FormDataGenerator struct {
+		logger *slog.Logger
+	}
+)
+
+// NewFormDataGenerator creates a new form-data generator
+//
+// Parameters:
+//
+//   - logger: optional logger to use for logging detected fields
+//
+// Returns:
+//
+//   - *FormDataGenerator: instance of the form-data generator
+func NewFormDataGenerator(logger *slog.Logger) *FormDataGenerator {
+	if logger != nil {
+		// Create a sub logger
+		logger = logger.With(
+			slog.String("component", "struct_mapper_form_data_generator"),
+		)
+	}
+
+	return &FormDataGenerator{
+		logger,
+	}
+}
+
+// NewMapper creates the fields to validate from a form-data struct, whose fields are named by the 'form' tag and can
+// hold uploaded files as *multipart.FileHeader or []*multipart.FileHeader
+//
+// Parameters:
+//
+//   - structInstance: instance of the form-data struct
+//
+// Returns:
+//
+//   - *Mapper: instance of the mapper
+//   - error: error if any
+func (f FormDataGenerator) NewMapper(structInstance any) (
+	*Mapper,
+	error,
+) {
+	return f.newMapper(structInstance, make(map[reflect.Type]bool))
+}
+
+// newMapper creates the fields to validate from a struct, skipping the nested mappers of the struct types that are
+// already being mapped, so recursive types do not recurse forever
+//
+// Parameters:
+//
+//   - structInstance: instance of the struct
+//   - parents: the struct types that are being mapped
+//
+// Returns:
+//
+//   - *Mapper: instance of the mapper
+//   - error: error if any
+func (f FormDataGenerator) newMapper(structInstance any, parents map[reflect.Type]bool) (
+	*Mapper,
+	error,
+) {
+	// Check if the struct instance is nil
+	if structInstance == nil {
+		return nil, ErrNilStructInstance
+	}
+
+	// Reflection of data
+	reflectedType := goreflect.GetDereferencedType(structInstance)
+
+	// Get the struct type name
+	structTypeName := reflectedType.Name()
+
+	// Initialize the root map of fields and the map of nested mappers
+	rootMapper, err := NewMapper(structInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark the struct type as being mapped
+	parents[reflectedType] = true
+	defer delete(parents, reflectedType)
+
+	// Reflection of the type of data
+	for i := 0; i < reflectedType.NumField(); i++ {
+		// Get the field type through reflection
+		structField := reflectedType.Field(i)
+		fieldType := structField.Type
+		fieldName := structField.Name
+
+		// Check if the field is unexported
+		if !goreflect.IsStructFieldExported(&structField) {
+			// Set field as not required
+			rootMapper.SetFieldIsRequired(fieldName, false)
+			continue
+		}
+
+		// Get the form-data name from the tag, the field name is used if it is not set
+		formTag := structField.Tag.Get(FormDataTag)
+		formTagOptions := strings.Split(formTag, ",")
+		formName := formTagOptions[0]
+		if formName == "" {
+			formName = fieldName
+		}
+
+		// Add field tag name to the map and set the field as parsed
+		rootMapper.AddFieldTagName(fieldName, formName)
+
+		// Add the rules named in the rules tag of the field
+		if rulesErr := AddFieldTagRules(rootMapper, &structField, nil); rulesErr != nil {
+			return nil, rulesErr
+		}
+
+		// Check if the form tag is unassigned or if it contains 'omitempty', which means it is an optional field
+		isOptional := formTag == "-"
+		for _, formTagOption := range formTagOptions[1:] {
+			if strings.TrimSpace(formTagOption) == FormDataOmitempty {
+				isOptional = true
+			}
+		}
+		if isOptional {
+			// Set field name as not required
+			rootMapper.SetFieldIsRequired(fieldName, false)
+
+			// Create a nested mapper for the optional struct fields, so they are validated when initialized if rules
+			// are added to it
+			if formTag != "-" {
+				if mapperErr := f.addFieldNestedMapper(rootMapper, fieldName, fieldType, parents); mapperErr != nil {
+					return nil, mapperErr
+				}
+			}
+
+			// Print field
+			DetectedField(
+				structTypeName,
+				fieldName,
+				fieldType,
+				formTag,
+				false,
+				f.logger,
+			)
+			continue
+		}
+
+		// Set field name as required
+		rootMapper.SetFieldIsRequired(fieldName, true)
+
+		// Dereference the pointer
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		// Create a nested mapper for the struct fields
+		if mapperErr := f.addFieldNestedMapper(rootMapper, fieldName, fieldType, parents); mapperErr != nil {
+			return nil, mapperErr
+		}
+
+		// Print field
+		DetectedField(
+			structTypeName,
+			fieldName,
+			fieldType,
+			formTag,
+			true,
+			f.logger,
+		)
+	}
+
+	return rootMapper, nil
+}
+
+// addFieldNestedMapper adds the nested mapper of a struct or pointer to struct field, unless its type is already
+// being mapped, the uploaded files are not
+// taken as nested structs
+//
+// Parameters:
+//
+//   - rootMapper: the mapper of the struct that holds the field
+//   - fieldName: the name of the field
+//   - fieldType: the type of the field
+//   - parents: the struct types that are being mapped
+//
+// Returns:
+//
+//   - error: error if any
+func (f FormDataGenerator) addFieldNestedMapper(
+	rootMapper *Mapper,
+	fieldName string,
+	fieldType reflect.Type,
+	parents map[reflect.Type]bool,
+) error {
+	// Dereference the pointer
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	// Check if the element type is a struct that is not being mapped
+	if fieldType.Kind() != reflect.Struct || fieldType == fileHeaderType || parents[fieldType] {
+		return nil
+	}
+
+	// Create a new Mapper for the nested struct field
+	fieldNestedMapper, err := f.newMapper(
+		reflect.New(fieldType).Interface(),
+		parents,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Add the nested fields to the map
+	rootMapper.AddFieldNestedMapper(fieldName, fieldNestedMapper)
+	return nil
+}
+
+// NewMapperWithNoError creates the fields to validate from a form-data struct
+//
+// Parameters:
+//
+//   - structInstance: instance of the form-data struct
+//
+// Returns:
+//
+//   - *Mapper: instance of the mapper
+func (f FormDataGenerator) NewMapperWithNoError(structInstance any) *Mapper {
+	mapper, err := f.NewMapper(structInstance)
+	if err != nil {
+		panic(err)
+	}
+	return mapper
+}
@@ -51,6 +51,25 @@ func NewJSONGenerator(logger *slog.Logger) *JSONGenerator {
 func (j JSONGenerator) NewMapper(structInstance any) (
 	*Mapper,
 	error,
+) {
+	return j.newMapper(structInstance, make(map[reflect.Type]bool))
+}
+
+// newMapper creates the fields to validate from a struct, skipping the nested mappers of the struct types that are
+// already being mapped, so recursive types do not recurse forever
+//
+// Parameters:
+//
+//   - structInstance: instance of the struct
+//   - parents: the struct types that are being mapped
+//
+// Returns:
+//
+//   - *Mapper: instance of the mapper
+//   - error: error if any
+func (j JSONGenerator) newMapper(structInstance any, parents map[reflect.Type]bool) (
+	*Mapper,
+	error,
 ) {
 	// Check if the struct instance is nil
 	if structInstance == nil {
@@ -69,6 +88,10 @@ func (j JSONGenerator) NewMapper(structInstance any) (
 		return nil, err
 	}
 
+	// Mark the struct type as being mapped
+	parents[reflectedType] = true
+	defer delete(parents, reflectedType)
+
 	// Reflection of the type of data
 	for i := 0; i < reflectedType.NumField(); i++ {
 		// Get the field type through reflection
@@ -98,11 +121,24 @@ func (j JSONGenerator) NewMapper(structInstance any) (
 		// Add field tag name to the map and set the field as parsed
 		rootMapper.AddFieldTagName(fieldName, jsonName)
 
+		// Add the rules named in the rules tag of the field
+		if rulesErr := AddFieldTagRules(rootMapper, &structField, nil); rulesErr != nil {
+			return nil, rulesErr
+		}
+
 		// Check if the JSON tag is unassigned or if it contains 'omitempty', which means it is an optional field
 		if jsonTag == "-" || strings.Contains(jsonTag, gostringsjson.JSONOmitempty) {
 			// Set field name as not required
 			rootMapper.SetFieldIsRequired(fieldName, false)
 
+			// Create a nested mapper for the optional struct fields, so they are validated when initialized if rules
+			// are added to it
+			if jsonTag != "-" {
+				if mapperErr := j.addFieldNestedMapper(rootMapper, fieldName, fieldType, parents); mapperErr != nil {
+					return nil, mapperErr
+				}
+			}
+
 			// Print field
 			DetectedField(
 				structTypeName,
@@ -123,18 +159,9 @@ func (j JSONGenerator) NewMapper(structInstance any) (
 			fieldType = fieldType.Elem()
 		}
 
-		// Check if the element type is a struct
-		if fieldType.Kind() == reflect.Struct {
-			// Create a new Mapper for the nested struct field
-			fieldNestedMapper, mapperErr := j.NewMapper(
-				reflect.New(fieldType).Interface(),
-			)
-			if mapperErr != nil {
-				return nil, mapperErr
-			}
-
-			// Add the nested fields to the map
-			rootMapper.AddFieldNestedMapper(fieldName, fieldNestedMapper)
+		// Create a nested mapper for the struct fields
+		if mapperErr := j.addFieldNestedMapper(rootMapper, fieldName, fieldType, parents); mapperErr != nil {
+			return nil, mapperErr
 		}
 
 		// Print field
@@ -151,6 +178,49 @@ func (j JSONGenerator) NewMapper(structInstance any) (
 	return rootMapper, nil
 }
 
+// addFieldNestedMapper adds the nested mapper of a struct or pointer to struct field, unless its type is already
+// being mapped
+//
+// Parameters:
+//
+//   - rootMapper: the mapper of the struct that holds the field
+//   - fieldName: the name of the field
+//   - fieldType: the type of the field
+//   - parents: the struct types that are being mapped
+//
+// Returns:
+//
+//   - error: error if any
+func (j JSONGenerator) addFieldNestedMapper(
+	rootMapper *Mapper,
+	fieldName string,
+	fieldType reflect.Type,
+	parents map[reflect.Type]bool,
+) error {
+	// Dereference the pointer
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	// Check if the element type is a struct that is not being mapped
+	if fieldType.Kind() != reflect.Struct || parents[fieldType] {
+		return nil
+	}
+
+	// Create a new Mapper for the nested struct field
+	fieldNestedMapper, err := j.newMapper(
+		reflect.New(fieldType).Interface(),
+		parents,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Add the nested fields to the map
+	rootMapper.AddFieldNestedMapper(fieldName, fieldNestedMapper)
+	return nil
+}
+
 // NewMapperWithNoError creates the fields to validate from a JSON struct
 //
 // Parameters:
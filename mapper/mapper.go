@@ -7,6 +7,12 @@ import (
 )
 
 type (
+	// FieldRule validates the value of an initialized field, returning its validation errors
+	FieldRule func(fieldValue any) []error
+
+	// StructRule validates a struct as a whole, returning the validation errors keyed by the field name
+	StructRule func(structInstance any) map[string][]error
+
 	// Mapper is a map of fields to validate from a struct
 	Mapper struct {
 		// uniqueTypeReference is the unique type reference of the struct
@@ -23,6 +29,12 @@ type (
 
 		// nestedMappers key is the field name of the nested struct and value is the nested mapper
 		nestedMappers map[string]*Mapper
+
+		// fieldRules key is the field name and value are the rules run on the field value when it is initialized
+		fieldRules map[string][]FieldRule
+
+		// structRules are the struct-level rules, which are run after the required fields validation
+		structRules []StructRule
 	}
 )
 
@@ -283,3 +295,91 @@ func (m *Mapper) AddFieldNestedMapper(fieldName string, nestedMapper *Mapper) {
 	// Add the nested mapper to the map
 	m.nestedMappers[fieldName] = nestedMapper
 }
+
+// GetFieldRules returns the rules of a field
+//
+// Parameters:
+//
+//   - fieldName: name of the field
+//
+// Returns:
+//
+//   - []FieldRule: the rules of the field
+func (m *Mapper) GetFieldRules(fieldName string) []FieldRule {
+	if m == nil || m.fieldRules == nil {
+		return nil
+	}
+	return m.fieldRules[fieldName]
+}
+
+// AddFieldRule adds a rule to a field, which is run on the field value when it is initialized, whether the field is
+// required or not
+//
+// Parameters:
+//
+//   - fieldName: name of the field
+//   - rule: the field rule to add
+func (m *Mapper) AddFieldRule(fieldName string, rule FieldRule) {
+	if m == nil || rule == nil {
+		return
+	}
+
+	// Initialize the field rules map if it is nil
+	if m.fieldRules == nil {
+		m.fieldRules = map[string][]FieldRule{}
+	}
+
+	// Add the rule to the field rules
+	m.fieldRules[fieldName] = append(m.fieldRules[fieldName], rule)
+}
+
+// GetStructRules returns the struct-level rules of the mapper
+//
+// Returns:
+//
+//   - []StructRule: the struct-level rules
+func (m *Mapper) GetStructRules() []StructRule {
+	if m == nil {
+		return nil
+	}
+	return m.structRules
+}
+
+// AddStructRule adds a struct-level rule to the mapper. To validate a nested struct as a whole, add the rule to its
+// nested mapper, e.g. mapper.GetFieldNestedMapper("Address").AddStructRule(rule). The optional nested structs are
+// only validated, including their required fields, when their nested mapper has rules
+//
+// Parameters:
+//
+//   - rule: the struct-level rule to add
+func (m *Mapper) AddStructRule(rule StructRule) {
+	if m == nil || rule == nil {
+		return
+	}
+	m.structRules = append(m.structRules, rule)
+}
+
+// HasRules checks if the mapper or any of its nested mappers has field or struct-level rules
+//
+// Returns:
+//
+//   - bool: true if there are rules, false otherwise
+func (m *Mapper) HasRules() bool {
+	if m == nil {
+		return false
+	}
+	if len(m.structRules) > 0 {
+		return true
+	}
+	for _, rules := range m.fieldRules {
+		if len(rules) > 0 {
+			return true
+		}
+	}
+	for _, nestedMapper := range m.nestedMappers {
+		if nestedMapper.HasRules() {
+			return true
+		}
+	}
+	return false
+}
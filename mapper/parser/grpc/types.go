@@ -4,18 +4,29 @@ import (
 	"fmt"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/protoadapt"
 
 	govalidatormapperparser "github.com/ralvarezdev/go-validator/mapper/parser"
 )
 
+const (
+	// FieldMetadataKey is the metadata key of the field name in the error infos
+	FieldMetadataKey = "field"
+)
+
 type (
 	// ErrorDetails is the struct for the error details wrapper
 	ErrorDetails struct {
 		fieldViolations []*errdetails.BadRequest_FieldViolation
+		errorInfos      []*errdetails.ErrorInfo
+		domain          string
 	}
 
 	// DefaultEndParser is the default implementation of the EndParser interface
-	DefaultEndParser struct{}
+	DefaultEndParser struct {
+		detailed bool
+		domain   string
+	}
 )
 
 // NewErrorDetails adds the root struct parsed validations to the error details
@@ -33,6 +44,27 @@ func NewErrorDetails(
 	structParsedValidations *govalidatormapperparser.StructParsedValidations,
 	parentFieldName *string,
 	fieldsViolations []*errdetails.BadRequest_FieldViolation,
+) (*ErrorDetails, error) {
+	return newErrorDetails(structParsedValidations, parentFieldName, fieldsViolations, "")
+}
+
+// newErrorDetails adds the root struct parsed validations to the error details
+//
+// Parameters:
+//
+//   - structParsedValidations: The root struct parsed validations to add
+//   - parentFieldName: The parent field name to prefix to the field names
+//   - fieldsViolations: The existing field violations to add to the error details
+//   - domain: The domain of the error infos
+//
+// Returns:
+//
+//   - error: An error if the root struct parsed validations are nil or if the fields are already in the error details
+func newErrorDetails(
+	structParsedValidations *govalidatormapperparser.StructParsedValidations,
+	parentFieldName *string,
+	fieldsViolations []*errdetails.BadRequest_FieldViolation,
+	domain string,
 ) (*ErrorDetails, error) {
 	// Check if the root struct parsed validations are nil
 	if structParsedValidations == nil {
@@ -40,15 +72,12 @@ func NewErrorDetails(
 	}
 
 	// Create the error details
-	var e *ErrorDetails
-	if fieldsViolations != nil {
-		e = &ErrorDetails{
-			fieldViolations: []*errdetails.BadRequest_FieldViolation{},
-		}
-	} else {
-		e = &ErrorDetails{
-			fieldViolations: fieldsViolations,
-		}
+	e := &ErrorDetails{
+		fieldViolations: fieldsViolations,
+		domain:          domain,
+	}
+	if e.fieldViolations == nil {
+		e.fieldViolations = []*errdetails.BadRequest_FieldViolation{}
 	}
 
 	// Add the struct parsed validations fields
@@ -117,11 +146,32 @@ func (e *ErrorDetails) AddField(
 	}
 
 	// Add the field parsed validations to the error details
-	for _, err := range fieldParsedValidations.GetErrors() {
-		e.fieldViolations = append(
-			e.fieldViolations, &errdetails.BadRequest_FieldViolation{
-				Field:       fieldName,
-				Description: err,
+	reasons := fieldParsedValidations.GetReasons()
+	metadata := fieldParsedValidations.GetMetadata()
+	for i, err := range fieldParsedValidations.GetErrors() {
+		fieldViolation := &errdetails.BadRequest_FieldViolation{
+			Field:       fieldName,
+			Description: err,
+		}
+		if i < len(reasons) {
+			fieldViolation.Reason = reasons[i]
+		}
+		e.fieldViolations = append(e.fieldViolations, fieldViolation)
+
+		// Add the metadata of the error as an error info, since the field violations cannot hold it
+		if i >= len(metadata) || len(metadata[i]) == 0 {
+			continue
+		}
+		errorInfoMetadata := make(map[string]string, len(metadata[i])+1)
+		for key, value := range metadata[i] {
+			errorInfoMetadata[key] = value
+		}
+		errorInfoMetadata[FieldMetadataKey] = fieldName
+		e.errorInfos = append(
+			e.errorInfos, &errdetails.ErrorInfo{
+				Reason:   fieldViolation.Reason,
+				Domain:   e.domain,
+				Metadata: errorInfoMetadata,
 			},
 		)
 	}
@@ -157,14 +207,19 @@ func (e *ErrorDetails) AddNestedStruct(
 	}
 
 	// Get the struct error details
-	_, err := NewErrorDetails(
+	nestedErrorDetails, err := newErrorDetails(
 		structParsedValidations,
 		&fieldName,
-		e.fieldViolations,
+		nil,
+		e.domain,
 	)
 	if err != nil {
 		return err
 	}
+
+	// Add the nested struct error details to the error details
+	e.fieldViolations = append(e.fieldViolations, nestedErrorDetails.fieldViolations...)
+	e.errorInfos = append(e.errorInfos, nestedErrorDetails.errorInfos...)
 	return nil
 }
 
@@ -182,6 +237,36 @@ func (e *ErrorDetails) GetBadRequest() *errdetails.BadRequest {
 	}
 }
 
+// GetErrorInfos gets the error infos with the metadata of the field errors, e.g. a suggested fix. Each error info has
+// the field name in its FieldMetadataKey metadata
+//
+// Returns:
+//
+//   - []*errdetails.ErrorInfo: The error infos
+func (e *ErrorDetails) GetErrorInfos() []*errdetails.ErrorInfo {
+	if e == nil {
+		return nil
+	}
+	return e.errorInfos
+}
+
+// GetDetails gets the BadRequest followed by the error infos, so they can be added to a status with WithDetails
+//
+// Returns:
+//
+//   - []protoadapt.MessageV1: The details
+func (e *ErrorDetails) GetDetails() []protoadapt.MessageV1 {
+	if e == nil {
+		return nil
+	}
+	details := make([]protoadapt.MessageV1, 0, len(e.errorInfos)+1)
+	details = append(details, e.GetBadRequest())
+	for _, errorInfo := range e.errorInfos {
+		details = append(details, errorInfo)
+	}
+	return details
+}
+
 // NewDefaultEndParser creates a new DefaultEndParser
 //
 // Returns:
@@ -191,6 +276,23 @@ func NewDefaultEndParser() DefaultEndParser {
 	return DefaultEndParser{}
 }
 
+// NewDetailedEndParser creates a new DefaultEndParser that parses the validations into the BadRequest followed by the
+// error infos with the metadata of the field errors
+//
+// Parameters:
+//
+//   - domain: The domain of the error infos, e.g. the service name
+//
+// Returns:
+//
+//   - DefaultEndParser: The new DefaultEndParser
+func NewDetailedEndParser(domain string) DefaultEndParser {
+	return DefaultEndParser{
+		detailed: true,
+		domain:   domain,
+	}
+}
+
 // ParseValidations parses the validations into a BadRequest
 //
 // Parameters:
@@ -211,9 +313,12 @@ func (d DefaultEndParser) ParseValidations(structParsedValidations *govalidatorm
 	}
 
 	// Convert the parsed validations to a BadRequest
-	errorDetails, err := NewErrorDetails(structParsedValidations, nil, nil)
+	errorDetails, err := newErrorDetails(structParsedValidations, nil, nil, d.domain)
 	if err != nil {
 		return nil, err
 	}
+	if d.detailed {
+		return errorDetails.GetDetails(), nil
+	}
 	return errorDetails.GetBadRequest(), nil
 }
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+	govalidatormapperparser "github.com/ralvarezdev/go-validator/mapper/parser"
+)
+
+func TestNewErrorDetails(t *testing.T) {
+	// Create the parsed validations of a struct with a nested struct
+	emailValidations := govalidatormapperparser.NewFieldParsedValidations()
+	emailValidations.AddErrors([]error{errors.New("email is required")})
+	streetValidations := govalidatormapperparser.NewFieldParsedValidations()
+	streetValidations.AddErrors(
+		[]error{
+			govalidatorfield.NewError("address.invalid_street", "street is not valid").
+				WithMetadata("limit", "64"),
+		},
+	)
+
+	addressValidations := govalidatormapperparser.NewNestedStructParsedValidations("address", "Address")
+	addressValidations.AddField("street", streetValidations)
+	structValidations := govalidatormapperparser.NewStructParsedValidations("Request")
+	structValidations.AddField("email", emailValidations)
+	structValidations.AddNestedStruct("address", addressValidations)
+
+	// Keep the existing field violations, followed by the root and nested ones
+	existingViolation := &errdetails.BadRequest_FieldViolation{Field: "id", Description: "id is required"}
+	errorDetails, err := NewErrorDetails(
+		structValidations,
+		nil,
+		[]*errdetails.BadRequest_FieldViolation{existingViolation},
+	)
+	if err != nil {
+		t.Fatalf("NewErrorDetails returned error: %v", err)
+	}
+
+	var fields []string
+	for _, fieldViolation := range errorDetails.GetBadRequest().GetFieldViolations() {
+		fields = append(fields, fieldViolation.GetField())
+	}
+	slices.Sort(fields)
+	if want := []string{"address.street", "email", "id"}; !slices.Equal(fields, want) {
+		t.Errorf("field violations = %v, want %v", fields, want)
+	}
+
+	// Keep the error infos of the nested struct
+	errorInfos := errorDetails.GetErrorInfos()
+	if len(errorInfos) != 1 || errorInfos[0].GetMetadata()[FieldMetadataKey] != "address.street" ||
+		errorInfos[0].GetReason() != "address.invalid_street" {
+		t.Errorf("error infos = %v, want the address.street info", errorInfos)
+	}
+}
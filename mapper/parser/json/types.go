@@ -9,11 +9,21 @@ import (
 type (
 	// FlattenedParsedValidations is the struct for the flattened parsed validations
 	FlattenedParsedValidations struct {
-		fields map[string]any
+		fields   map[string]any
+		detailed bool
+	}
+
+	// FieldError is the detailed form of a field error, with its machine-readable reason and metadata
+	FieldError struct {
+		Message  string            `json:"message"`
+		Reason   string            `json:"reason,omitempty"`
+		Metadata map[string]string `json:"metadata,omitempty"`
 	}
 
 	// DefaultEndParser is the default implementation of the EndParser interface
-	DefaultEndParser struct{}
+	DefaultEndParser struct {
+		detailed bool
+	}
 )
 
 // NewFlattenedParsedValidations adds the root struct parsed validations to the flattened parsed validations
@@ -28,6 +38,41 @@ type (
 // validations
 func NewFlattenedParsedValidations(
 	structParsedValidations *govalidatormapperparser.StructParsedValidations,
+) (*FlattenedParsedValidations, error) {
+	return newFlattenedParsedValidations(structParsedValidations, false)
+}
+
+// NewDetailedFlattenedParsedValidations adds the root struct parsed validations to the flattened parsed validations,
+// where each field holds its FieldError list instead of its error messages
+//
+// Parameters:
+//
+//   - structParsedValidations: The root struct parsed validations to add
+//
+// Returns:
+//
+// - error: An error if the root struct parsed validations are nil or if the fields are already in the flattened parsed
+// validations
+func NewDetailedFlattenedParsedValidations(
+	structParsedValidations *govalidatormapperparser.StructParsedValidations,
+) (*FlattenedParsedValidations, error) {
+	return newFlattenedParsedValidations(structParsedValidations, true)
+}
+
+// newFlattenedParsedValidations adds the root struct parsed validations to the flattened parsed validations
+//
+// Parameters:
+//
+//   - structParsedValidations: The root struct parsed validations to add
+//   - detailed: Whether the fields hold their FieldError list instead of their error messages
+//
+// Returns:
+//
+// - error: An error if the root struct parsed validations are nil or if the fields are already in the flattened parsed
+// validations
+func newFlattenedParsedValidations(
+	structParsedValidations *govalidatormapperparser.StructParsedValidations,
+	detailed bool,
 ) (*FlattenedParsedValidations, error) {
 	// Check if the root struct parsed validations are nil
 	if structParsedValidations == nil {
@@ -36,7 +81,8 @@ func NewFlattenedParsedValidations(
 
 	// Create the flattened parsed validations
 	f := &FlattenedParsedValidations{
-		fields: make(map[string]any),
+		fields:   make(map[string]any),
+		detailed: detailed,
 	}
 
 	// Add the struct parsed validations fields
@@ -100,7 +146,26 @@ func (f *FlattenedParsedValidations) AddField(
 	}
 
 	// Add the field parsed validations to the flattened parsed validations
-	f.fields[fieldName] = fieldParsedValidations.GetErrors()
+	if !f.detailed {
+		f.fields[fieldName] = fieldParsedValidations.GetErrors()
+		return nil
+	}
+
+	// Add the reason and the metadata of each error
+	reasons := fieldParsedValidations.GetReasons()
+	metadata := fieldParsedValidations.GetMetadata()
+	fieldErrors := make([]FieldError, 0, len(fieldParsedValidations.GetErrors()))
+	for i, err := range fieldParsedValidations.GetErrors() {
+		fieldError := FieldError{Message: err}
+		if i < len(reasons) {
+			fieldError.Reason = reasons[i]
+		}
+		if i < len(metadata) {
+			fieldError.Metadata = metadata[i]
+		}
+		fieldErrors = append(fieldErrors, fieldError)
+	}
+	f.fields[fieldName] = fieldErrors
 	return nil
 }
 
@@ -138,7 +203,7 @@ func (f *FlattenedParsedValidations) AddNestedStruct(
 	}
 
 	// Get the struct flattened parsed validations
-	structFlattenedParsedValidations, err := NewFlattenedParsedValidations(structParsedValidations)
+	structFlattenedParsedValidations, err := newFlattenedParsedValidations(structParsedValidations, f.detailed)
 	if err != nil {
 		return err
 	}
@@ -169,6 +234,16 @@ func NewDefaultEndParser() DefaultEndParser {
 	return DefaultEndParser{}
 }
 
+// NewDetailedEndParser creates a new DefaultEndParser that parses each field error into a FieldError, so the clients
+// get its reason and metadata, e.g. a suggested fix
+//
+// Returns:
+//
+//   - DefaultEndParser: The new DefaultEndParser
+func NewDetailedEndParser() DefaultEndParser {
+	return DefaultEndParser{detailed: true}
+}
+
 // ParseValidations parses the validations into a flattened map[string]any
 //
 // Parameters:
@@ -191,8 +266,9 @@ func (d DefaultEndParser) ParseValidations(structParsedValidations *govalidatorm
 	}
 
 	// Flatten the parsed validations
-	flattenedParsedValidations, err := NewFlattenedParsedValidations(
+	flattenedParsedValidations, err := newFlattenedParsedValidations(
 		structParsedValidations,
+		d.detailed,
 	)
 	if err != nil {
 		return nil, err
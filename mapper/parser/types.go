@@ -5,6 +5,7 @@ import (
 
 	gostringsconvert "github.com/ralvarezdev/go-strings/convert"
 
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
 	govalidatormappervalidation "github.com/ralvarezdev/go-validator/mapper/validation"
 )
 
@@ -19,7 +20,9 @@ type (
 
 	// FieldParsedValidations is the struct for the field parsed validations
 	FieldParsedValidations struct {
-		errors []string
+		errors   []string
+		reasons  []string
+		metadata []map[string]string
 	}
 
 	// DefaultRawParser is a struct that holds the default raw parser
@@ -231,6 +234,13 @@ func (f *FieldParsedValidations) AddErrors(errors []error) {
 		return
 	}
 	f.errors = append(f.errors, mappedErrors...)
+
+	// Append the reasons and the metadata of the errors, empty if the error has no code
+	for _, err := range errors {
+		reason, _ := govalidatorfield.GetCode(err)
+		f.reasons = append(f.reasons, reason)
+		f.metadata = append(f.metadata, govalidatorfield.GetMetadata(err))
+	}
 }
 
 // AddError adds an error to the field parsed validations
@@ -255,6 +265,8 @@ func (f *FieldParsedValidations) AddError(err string) {
 
 	// Append the error to the field parsed validations
 	f.errors = append(f.errors, err)
+	f.reasons = append(f.reasons, "")
+	f.metadata = append(f.metadata, nil)
 }
 
 // GetErrors returns the errors from the field parsed validations
@@ -269,6 +281,32 @@ func (f *FieldParsedValidations) GetErrors() []string {
 	return f.errors
 }
 
+// GetReasons returns the machine-readable reasons of the errors from the field parsed validations, in the same order as
+// the errors. The reason is empty if the error has no code
+//
+// Returns:
+//
+//   - []string: The reasons
+func (f *FieldParsedValidations) GetReasons() []string {
+	if f == nil {
+		return nil
+	}
+	return f.reasons
+}
+
+// GetMetadata returns the structured metadata of the errors from the field parsed validations, in the same order as
+// the errors, e.g. a suggested fix. The metadata is nil if the error has none
+//
+// Returns:
+//
+//   - []map[string]string: The metadata
+func (f *FieldParsedValidations) GetMetadata() []map[string]string {
+	if f == nil {
+		return nil
+	}
+	return f.metadata
+}
+
 // NewDefaultRawParser creates a new DefaultRawParser struct
 //
 // Parameters:
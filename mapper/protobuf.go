@@ -50,6 +50,25 @@ func NewProtobufGenerator(logger *slog.Logger) *ProtobufGenerator {
 func (p ProtobufGenerator) NewMapper(structInstance any) (
 	*Mapper,
 	error,
+) {
+	return p.newMapper(structInstance, make(map[reflect.Type]bool))
+}
+
+// newMapper creates the fields to validate from a struct, skipping the nested mappers of the struct types that are
+// already being mapped, so recursive types do not recurse forever
+//
+// Parameters:
+//
+//   - structInstance: instance of the struct
+//   - parents: the struct types that are being mapped
+//
+// Returns:
+//
+//   - *Mapper: instance of the mapper
+//   - error: error if any
+func (p ProtobufGenerator) newMapper(structInstance any, parents map[reflect.Type]bool) (
+	*Mapper,
+	error,
 ) {
 	// Check if the struct instance is nil
 	if structInstance == nil {
@@ -68,6 +87,10 @@ func (p ProtobufGenerator) NewMapper(structInstance any) (
 		return nil, err
 	}
 
+	// Mark the struct type as being mapped
+	parents[reflectedType] = true
+	defer delete(parents, reflectedType)
+
 	// Reflection of the type of data
 	for i := 0; i < reflectedType.NumField(); i++ {
 		// Get the field type through reflection
@@ -108,6 +131,11 @@ func (p ProtobufGenerator) NewMapper(structInstance any) (
 		// Add the field to the fields map
 		rootMapper.AddFieldTagName(fieldName, protobufName)
 
+		// Add the rules named in the rules tag of the field
+		if rulesErr := AddFieldTagRules(rootMapper, &structField, nil); rulesErr != nil {
+			return nil, rulesErr
+		}
+
 		// Check if the field is a pointer
 		if fieldType.Kind() == reflect.Ptr {
 			// Dereference the pointer
@@ -119,6 +147,12 @@ func (p ProtobufGenerator) NewMapper(structInstance any) (
 				// Set field as not required
 				rootMapper.SetFieldIsRequired(fieldName, false)
 
+				// Create a nested mapper for the optional struct fields, so they are validated when initialized if
+				// rules are added to it
+				if mapperErr := p.addFieldNestedMapper(rootMapper, fieldName, fieldType, parents); mapperErr != nil {
+					return nil, mapperErr
+				}
+
 				// Print field
 				DetectedField(
 					structTypeName,
@@ -132,15 +166,9 @@ func (p ProtobufGenerator) NewMapper(structInstance any) (
 			}
 
 			// Create a new Mapper for the nested struct field
-			fieldNestedMapper, mapperErr := p.NewMapper(
-				reflect.New(fieldType).Interface(),
-			)
-			if mapperErr != nil {
+			if mapperErr := p.addFieldNestedMapper(rootMapper, fieldName, fieldType, parents); mapperErr != nil {
 				return nil, mapperErr
 			}
-
-			// Add the nested fields to the map
-			rootMapper.AddFieldNestedMapper(fieldName, fieldNestedMapper)
 		}
 
 		// Set field as required
@@ -160,6 +188,49 @@ func (p ProtobufGenerator) NewMapper(structInstance any) (
 	return rootMapper, nil
 }
 
+// addFieldNestedMapper adds the nested mapper of a struct or pointer to struct field, unless its type is already
+// being mapped
+//
+// Parameters:
+//
+//   - rootMapper: the mapper of the struct that holds the field
+//   - fieldName: the name of the field
+//   - fieldType: the type of the field
+//   - parents: the struct types that are being mapped
+//
+// Returns:
+//
+//   - error: error if any
+func (p ProtobufGenerator) addFieldNestedMapper(
+	rootMapper *Mapper,
+	fieldName string,
+	fieldType reflect.Type,
+	parents map[reflect.Type]bool,
+) error {
+	// Dereference the pointer
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	// Check if the element type is a struct that is not being mapped
+	if fieldType.Kind() != reflect.Struct || parents[fieldType] {
+		return nil
+	}
+
+	// Create a new Mapper for the nested struct field
+	fieldNestedMapper, err := p.newMapper(
+		reflect.New(fieldType).Interface(),
+		parents,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Add the nested fields to the map
+	rootMapper.AddFieldNestedMapper(fieldName, fieldNestedMapper)
+	return nil
+}
+
 // NewMapperWithNoError creates the fields to validate from a Protobuf compiled struct
 //
 // Parameters:
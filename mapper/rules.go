@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+const (
+	// RulesTag is the struct tag with the comma-separated names of the field rules, which are resolved from
+	// field.DefaultRules(), e.g. `rules:"uuid_v7"`
+	RulesTag = "rules"
+)
+
+// AddFieldTagRules adds the rules named in the rules tag of a struct field to the mapper
+//
+// Parameters:
+//
+//   - mapper: the mapper of the struct that holds the field
+//   - structField: the struct field
+//   - rules: the rule registry used to resolve the rule names (optional, if nil field.DefaultRules() is used)
+//
+// Returns:
+//
+//   - error: if the mapper or the struct field are nil, or if a rule is not registered
+func AddFieldTagRules(
+	mapper *Mapper,
+	structField *reflect.StructField,
+	rules *govalidatorfield.Rules,
+) error {
+	if mapper == nil {
+		return ErrNilMapper
+	}
+	if structField == nil {
+		return ErrNilStructField
+	}
+	if rules == nil {
+		rules = govalidatorfield.DefaultRules()
+	}
+
+	// Get the rules tag of the field
+	rulesTag := structField.Tag.Get(RulesTag)
+	if rulesTag == "" {
+		return nil
+	}
+
+	// Add the named rules to the field
+	for _, ruleName := range strings.Split(rulesTag, ",") {
+		ruleName = strings.TrimSpace(ruleName)
+		if ruleName == "" {
+			continue
+		}
+		rule, ok := rules.Lookup(ruleName)
+		if !ok {
+			return fmt.Errorf(ErrUnknownFieldRule, ruleName, structField.Name)
+		}
+		mapper.AddFieldRule(structField.Name, FieldRule(rule))
+	}
+	return nil
+}
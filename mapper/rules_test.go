@@ -0,0 +1,79 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	govalidatorfield "github.com/ralvarezdev/go-validator/field"
+)
+
+func TestAddFieldTagRules(t *testing.T) {
+	errTest := errors.New("test rule error")
+	rules := govalidatorfield.NewRules()
+	rules.Register(
+		"test", func(fieldValue any) []error {
+			return []error{errTest}
+		},
+	)
+
+	type request struct {
+		Untagged string
+		Tagged   string `rules:"test, test"`
+		Unknown  string `rules:"unknown"`
+	}
+	reflectedType := reflect.TypeOf(request{})
+	for _, tt := range []struct {
+		fieldName string
+		rules     int
+		err       bool
+	}{
+		{fieldName: "Untagged"},
+		{fieldName: "Tagged", rules: 2},
+		{fieldName: "Unknown", err: true},
+	} {
+		mapper, err := NewMapper(request{})
+		if err != nil {
+			t.Fatalf("NewMapper() = %v", err)
+		}
+		structField, _ := reflectedType.FieldByName(tt.fieldName)
+		if err = AddFieldTagRules(mapper, &structField, rules); (err != nil) != tt.err {
+			t.Fatalf("AddFieldTagRules(%s) = %v, want error %v", tt.fieldName, err, tt.err)
+		}
+		fieldRules := mapper.GetFieldRules(tt.fieldName)
+		if len(fieldRules) != tt.rules {
+			t.Fatalf("AddFieldTagRules(%s) added %d rules, want %d", tt.fieldName, len(fieldRules), tt.rules)
+		}
+		for _, fieldRule := range fieldRules {
+			if errs := fieldRule(""); len(errs) != 1 || !errors.Is(errs[0], errTest) {
+				t.Errorf("%s rule() = %v, want %v", tt.fieldName, errs, errTest)
+			}
+		}
+	}
+}
+
+func TestJSONGeneratorRulesTag(t *testing.T) {
+	govalidatorfield.DefaultRules().Register(
+		"json_generator_test", func(fieldValue any) []error {
+			return nil
+		},
+	)
+
+	type request struct {
+		ID string `json:"id" rules:"json_generator_test"`
+	}
+	mapper, err := NewJSONGenerator(nil).NewMapper(request{})
+	if err != nil {
+		t.Fatalf("NewMapper() = %v", err)
+	}
+	if got := len(mapper.GetFieldRules("ID")); got != 1 {
+		t.Errorf("len(GetFieldRules(ID)) = %d, want 1", got)
+	}
+
+	type unknownRequest struct {
+		ID string `json:"id" rules:"json_generator_test_unknown"`
+	}
+	if _, err = NewJSONGenerator(nil).NewMapper(unknownRequest{}); err == nil {
+		t.Errorf("NewMapper() = nil, want an unknown rule error")
+	}
+}
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"context"
 	"time"
 
 	govalidatormapper "github.com/ralvarezdev/go-validator/mapper"
@@ -51,6 +52,271 @@ type (
 		) (any, error)
 	}
 
+	// ContextService interface for the validations that may call external services, like DNS lookups or password
+	// history stores
+	ContextService interface {
+		EmailWithContext(
+			ctx context.Context,
+			emailField string,
+			email string,
+			validations *govalidatormappervalidation.StructValidations,
+		) error
+		URLWithContext(
+			ctx context.Context,
+			urlField string,
+			url string,
+			options *URLOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		) error
+		BirthdateWithContext(
+			ctx context.Context,
+			birthdateField string,
+			birthdate time.Time,
+			validations *govalidatormappervalidation.StructValidations,
+		) error
+		PasswordWithContext(
+			ctx context.Context,
+			passwordField string,
+			userID string,
+			password string,
+			validations *govalidatormappervalidation.StructValidations,
+		) error
+		CreateValidateWithContextFn(
+			mapper *govalidatormapper.Mapper,
+			cache bool,
+			auxiliaryValidatorFns ...any,
+		) (
+			ValidateWithContextFn, error,
+		)
+		ValidateWithContext(
+			ctx context.Context,
+			mapper *govalidatormapper.Mapper,
+			toValidate any,
+			auxiliaryValidatorFns ...any,
+		) (any, error)
+	}
+
+	// PersonalService interface for the personal data validations
+	PersonalService interface {
+		Phone(
+			phoneField string,
+			phone string,
+			region string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		NationalID(
+			nationalIDField string,
+			nationalID string,
+			country string,
+			documentType NationalIDDocumentType,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		CivilBirthdate(
+			birthdateField string,
+			birthdate any,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		BirthdateWithJurisdiction(
+			birthdateField string,
+			birthdate time.Time,
+			jurisdiction string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		CivilBirthdateWithJurisdiction(
+			birthdateField string,
+			birthdate any,
+			jurisdiction string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+	}
+
+	// NetworkService interface for the network validations
+	NetworkService interface {
+		URL(
+			urlField string,
+			url string,
+			options *URLOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Hostname(
+			hostnameField string,
+			hostname string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		IP(
+			ipField string,
+			ip string,
+			family IPFamily,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		CIDR(
+			cidrField string,
+			cidr string,
+			options *CIDROptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Port(
+			portField string,
+			port int,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+	}
+
+	// FinanceService interface for the financial validations
+	FinanceService interface {
+		Card(
+			cardField string,
+			card string,
+			options *CardOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		IBAN(
+			ibanField string,
+			iban string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		BIC(
+			bicField string,
+			bic string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Currency(
+			currencyField string,
+			currency string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+	}
+
+	// IdentifierService interface for the identifier validations
+	IdentifierService interface {
+		UUID(
+			uuidField string,
+			uuid string,
+			options *UUIDOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		ULID(
+			ulidField string,
+			ulid string,
+			window *TimeWindow,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		KSUID(
+			ksuidField string,
+			ksuid string,
+			window *TimeWindow,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Snowflake(
+			snowflakeField string,
+			snowflake string,
+			options *SnowflakeOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+	}
+
+	// ISOService interface for the ISO reference data validations
+	ISOService interface {
+		Country(
+			countryField string,
+			country string,
+			options *ISOOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Subdivision(
+			subdivisionField string,
+			subdivision string,
+			options *ISOOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Language(
+			languageField string,
+			language string,
+			options *ISOOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Locale(
+			localeField string,
+			locale string,
+			options *ISOOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Timezone(
+			timezoneField string,
+			timezone string,
+			options *ISOOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+	}
+
+	// FormatService interface for the format validations
+	FormatService interface {
+		Number(
+			numberField string,
+			number any,
+			options *NumberOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Text(
+			textField string,
+			text string,
+			options *TextOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Semver(
+			semverField string,
+			semver string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Cron(
+			cronField string,
+			cron string,
+			options *CronOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Regex(
+			regexField string,
+			regex string,
+			options *RegexOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		JSONPointer(
+			jsonPointerField string,
+			jsonPointer string,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		MIMEType(
+			mimeTypeField string,
+			mimeType string,
+			options *MIMEOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+	}
+
+	// ContentService interface for the file, location and sensitive content validations
+	ContentService interface {
+		File(
+			fileField string,
+			file any,
+			options *FileOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		GeoPoint(
+			latitudeField string,
+			longitudeField string,
+			latitude float64,
+			longitude float64,
+			options *GeoOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+		Secrets(
+			textField string,
+			text string,
+			options *SecretsOptions,
+			validations *govalidatormappervalidation.StructValidations,
+		)
+	}
+
 	// Validator interface
 	Validator interface {
 		ValidateRequiredFields(
@@ -1,18 +1,29 @@
 package validator
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
-	"net/mail"
 	"reflect"
 	"time"
 
 	goreflect "github.com/ralvarezdev/go-reflect"
-	gostringscount "github.com/ralvarezdev/go-strings/count"
 
 	govalidatorfieldbirthdate "github.com/ralvarezdev/go-validator/field/birthdate"
+	govalidatorfieldfile "github.com/ralvarezdev/go-validator/field/file"
+	govalidatorfieldfinance "github.com/ralvarezdev/go-validator/field/finance"
+	govalidatorfieldformat "github.com/ralvarezdev/go-validator/field/format"
+	govalidatorfieldgeo "github.com/ralvarezdev/go-validator/field/geo"
+	govalidatorfieldidentifier "github.com/ralvarezdev/go-validator/field/identifier"
+	govalidatorfieldiso "github.com/ralvarezdev/go-validator/field/iso"
 	govalidatorfieldmail "github.com/ralvarezdev/go-validator/field/mail"
+	govalidatorfieldnationalid "github.com/ralvarezdev/go-validator/field/nationalid"
+	govalidatorfieldnetwork "github.com/ralvarezdev/go-validator/field/network"
+	govalidatorfieldnumber "github.com/ralvarezdev/go-validator/field/number"
 	govalidatorfieldpassword "github.com/ralvarezdev/go-validator/field/password"
+	govalidatorfieldphone "github.com/ralvarezdev/go-validator/field/phone"
+	govalidatorfieldsecrets "github.com/ralvarezdev/go-validator/field/secrets"
+	govalidatorfieldtext "github.com/ralvarezdev/go-validator/field/text"
 	govalidatorfieldusername "github.com/ralvarezdev/go-validator/field/username"
 	govalidatormapper "github.com/ralvarezdev/go-validator/mapper"
 	govalidatormapperparser "github.com/ralvarezdev/go-validator/mapper/parser"
@@ -22,28 +33,100 @@ import (
 type (
 	// DefaultService struct
 	DefaultService struct {
-		rawParser        govalidatormapperparser.RawParser
-		endParser        govalidatormapperparser.EndParser
-		validator        Validator
-		validateFns      map[string]ValidateFn
-		birthdateOptions *BirthdateOptions
-		passwordOptions  *PasswordOptions
-		logger           *slog.Logger
+		rawParser              govalidatormapperparser.RawParser
+		endParser              govalidatormapperparser.EndParser
+		validator              Validator
+		validateFns            map[string]ValidateFn
+		validateWithContextFns map[string]ValidateWithContextFn
+		birthdateOptions       *BirthdateOptions
+		passwordOptions        *PasswordOptions
+		emailOptions           *EmailOptions
+		usernameOptions        *UsernameOptions
+		phoneOptions           *PhoneOptions
+		clock                  Clock
+		logger                 *slog.Logger
 	}
 
-	// BirthdateOptions is the birthdate options struct
-	BirthdateOptions struct {
-		MinimumAge int
-		MaximumAge int
+	// ServiceOptions is the struct of the default options of the field validations, each of them is optional
+	ServiceOptions struct {
+		Birthdate *BirthdateOptions
+		Password  *PasswordOptions
+		Email     *EmailOptions
+		Username  *UsernameOptions
+		Phone     *PhoneOptions
+
+		// Clock is used to get the current time (optional, if nil the system clock is used)
+		Clock Clock
 	}
 
+	// BirthdateOptions is the birthdate options struct
+	BirthdateOptions = govalidatorfieldbirthdate.Options
+
+	// Clock is the interface used to get the current time
+	Clock = govalidatorfieldbirthdate.Clock
+
 	// PasswordOptions is the password options struct
-	PasswordOptions struct {
-		MinimumLength       int
-		MinimumSpecialCount int
-		MinimumNumbersCount int
-		MinimumCapsCount    int
-	}
+	PasswordOptions = govalidatorfieldpassword.Options
+
+	// EmailOptions is the email options struct
+	EmailOptions = govalidatorfieldmail.Options
+
+	// UsernameOptions is the username options struct
+	UsernameOptions = govalidatorfieldusername.Options
+
+	// PhoneOptions is the phone number options struct
+	PhoneOptions = govalidatorfieldphone.Options
+
+	// URLOptions is the URL options struct
+	URLOptions = govalidatorfieldnetwork.URLOptions
+
+	// CIDROptions is the CIDR prefix options struct
+	CIDROptions = govalidatorfieldnetwork.CIDROptions
+
+	// CardOptions is the card number options struct
+	CardOptions = govalidatorfieldfinance.CardOptions
+
+	// UUIDOptions is the UUID options struct
+	UUIDOptions = govalidatorfieldidentifier.UUIDOptions
+
+	// SnowflakeOptions is the snowflake ID options struct
+	SnowflakeOptions = govalidatorfieldidentifier.SnowflakeOptions
+
+	// TimeWindow is the time window of the time-ordered identifiers
+	TimeWindow = govalidatorfieldidentifier.TimeWindow
+
+	// ISOOptions is the ISO reference data options struct
+	ISOOptions = govalidatorfieldiso.Options
+
+	// NationalIDDocumentType is the type of a national identity or tax document
+	NationalIDDocumentType = govalidatorfieldnationalid.DocumentType
+
+	// IPFamily is the bit set of the IP address families
+	IPFamily = govalidatorfieldnetwork.Family
+
+	// NumberOptions is the numeric constraints struct
+	NumberOptions = govalidatorfieldnumber.Options
+
+	// TextOptions is the free-text policy struct
+	TextOptions = govalidatorfieldtext.Options
+
+	// CronOptions is the cron expression options struct
+	CronOptions = govalidatorfieldformat.CronOptions
+
+	// RegexOptions is the regular expression options struct
+	RegexOptions = govalidatorfieldformat.RegexOptions
+
+	// MIMEOptions is the MIME type options struct
+	MIMEOptions = govalidatorfieldformat.MIMEOptions
+
+	// FileOptions is the file upload policy struct
+	FileOptions = govalidatorfieldfile.Options
+
+	// GeoOptions is the geographic point options struct
+	GeoOptions = govalidatorfieldgeo.Options
+
+	// SecretsOptions is the sensitive data detection options struct
+	SecretsOptions = govalidatorfieldsecrets.Options
 )
 
 // NewDefaultService creates a new default validator service
@@ -53,8 +136,7 @@ type (
 //   - rawParser: the raw parser to use
 //   - endParser: the end parser to use
 //   - validator: the validator to use
-//   - birthdateOptions: the default birthdate options (optional, can be nil)
-//   - passwordOptions: the default password options (optional, can be nil)
+//   - options: the default options of the field validations (optional, can be nil)
 //   - logger: the logger to use
 //
 // Returns:
@@ -65,8 +147,7 @@ func NewDefaultService(
 	rawParser govalidatormapperparser.RawParser,
 	endParser govalidatormapperparser.EndParser,
 	validator Validator,
-	birthdateOptions *BirthdateOptions,
-	passwordOptions *PasswordOptions,
+	options *ServiceOptions,
 	logger *slog.Logger,
 ) (*DefaultService, error) {
 	// Check if the raw parser, end parser or the validator is nil
@@ -79,6 +160,15 @@ func NewDefaultService(
 	if validator == nil {
 		return nil, ErrNilValidator
 	}
+	if options == nil {
+		options = &ServiceOptions{}
+	}
+
+	// Use the system clock by default
+	clock := options.Clock
+	if clock == nil {
+		clock = govalidatorfieldbirthdate.NewSystemClock()
+	}
 
 	if logger != nil {
 		logger = logger.With(slog.String("component", "validator_service"))
@@ -88,8 +178,12 @@ func NewDefaultService(
 		rawParser:        rawParser,
 		endParser:        endParser,
 		validator:        validator,
-		birthdateOptions: birthdateOptions,
-		passwordOptions:  passwordOptions,
+		birthdateOptions: options.Birthdate,
+		passwordOptions:  options.Password,
+		emailOptions:     options.Email,
+		usernameOptions:  options.Username,
+		phoneOptions:     options.Phone,
+		clock:            clock,
 		logger:           logger,
 	}, nil
 }
@@ -174,16 +268,20 @@ func (d *DefaultService) Username(
 		return
 	}
 
-	// Check if the username contains non-alphanumeric characters
-	if gostringscount.Alphanumeric(username) != len(username) {
-		validations.AddFieldValidationError(
-			usernameField,
-			govalidatorfieldusername.ErrMustBeAlphanumeric,
-		)
+	// Validate the username against the username policy
+	for _, err := range govalidatorfieldusername.Validate(
+		username,
+		d.usernameOptions,
+	) {
+		validations.AddFieldValidationError(usernameField, err)
 	}
 }
 
-// Email validates the email address field
+// Email validates the email address field.
+//
+// It does not check the domain deliverability, which requires DNS lookups, even if the email options have a
+// deliverability checker. In that case a warning is logged, use EmailWithContext from a context-aware auxiliary
+// validator function run by ValidateWithContext instead
 //
 // Parameters:
 //
@@ -199,281 +297,1397 @@ func (d *DefaultService) Email(
 		return
 	}
 
-	// Check if the mail address is empty
-	if email == "" {
-		validations.AddFieldValidationError(
-			emailField,
-			govalidatorfieldmail.ErrInvalidMailAddress,
+	// Warn that the deliverability check is skipped, since it requires a context
+	if d.emailOptions != nil && d.emailOptions.Deliverability != nil && d.logger != nil {
+		d.logger.Warn(
+			"Skipped email deliverability check without a context, use EmailWithContext instead",
+			slog.String("field_name", emailField),
 		)
-		return
 	}
 
-	// Check if the mail address is valid
-	if _, err := mail.ParseAddress(email); err != nil {
-		validations.AddFieldValidationError(
-			emailField,
-			govalidatorfieldmail.ErrInvalidMailAddress,
-		)
+	// Validate the mail address
+	for _, err := range govalidatorfieldmail.Validate(email, d.emailOptions) {
+		validations.AddFieldValidationError(emailField, err)
 	}
 }
 
-// Birthdate validates the birthdate field
+// Phone validates the phone number field
 //
 // Parameters:
 //
-// - birthdateField: the birthdate field name
-// - birthdate: the birthdate to validate
-// - validations: the struct validations
-func (d *DefaultService) Birthdate(
-	birthdateField string,
-	birthdate time.Time,
+//   - phoneField: the phone number field name
+//   - phone: the phone number to validate
+//   - region: the ISO 3166-1 alpha-2 region used to parse the numbers in the national format, e.g. taken from a
+//     sibling field (optional, if empty the default region of the phone number options is used)
+//   - validations: the struct validations
+func (d *DefaultService) Phone(
+	phoneField string,
+	phone string,
+	region string,
 	validations *govalidatormappervalidation.StructValidations,
 ) {
 	if d == nil {
 		return
 	}
 
-	// Check if the birthdate is after the current time
-	if birthdate.After(time.Now()) {
-		validations.AddFieldValidationError(
-			birthdateField,
-			govalidatorfieldbirthdate.ErrInvalidBirthdate,
-		)
+	// Validate the phone number against its region metadata
+	for _, err := range govalidatorfieldphone.Validate(phone, region, d.phoneOptions) {
+		validations.AddFieldValidationError(phoneField, err)
 	}
+}
 
-	// Check if the birthdate options are nil
-	if d.birthdateOptions == nil {
+// URL validates the URL field
+//
+// Parameters:
+//
+//   - urlField: the URL field name
+//   - url: the URL to validate
+//   - options: the URL options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) URL(
+	urlField string,
+	url string,
+	options *URLOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
 		return
 	}
 
-	// Check if the birthdate is before the minimum age
-	if d.birthdateOptions.MinimumAge > 0 {
-		if time.Now().AddDate(
-			-d.birthdateOptions.MinimumAge,
-			0,
-			0,
-		).Before(birthdate) {
-			validations.AddFieldValidationError(
-				birthdateField,
-				fmt.Errorf(
-					govalidatorfieldbirthdate.ErrMinimumAge,
-					d.birthdateOptions.MinimumAge,
-				),
-			)
-		}
+	// Validate the URL
+	for _, err := range govalidatorfieldnetwork.ValidateURL(url, options) {
+		validations.AddFieldValidationError(urlField, err)
 	}
+}
 
-	// Check if the birthdate is after the maximum age
-	if d.birthdateOptions.MaximumAge > 0 {
-		if time.Now().AddDate(
-			-d.birthdateOptions.MaximumAge,
-			0,
-			0,
-		).After(birthdate) {
-			validations.AddFieldValidationError(
-				birthdateField,
-				fmt.Errorf(
-					govalidatorfieldbirthdate.ErrMaximumAge,
-					d.birthdateOptions.MaximumAge,
-				),
-			)
-		}
+// URLWithContext validates the URL field, including the resolution of its hostname when the options deny the private
+// addresses
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - urlField: the URL field name
+//   - url: the URL to validate
+//   - options: the URL options (optional, can be nil)
+//   - validations: the struct validations
+//
+// Returns:
+//
+//   - error: if the context was canceled or the hostname lookup failed
+func (d *DefaultService) URLWithContext(
+	ctx context.Context,
+	urlField string,
+	url string,
+	options *URLOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) error {
+	if d == nil {
+		return ErrNilService
+	}
+
+	// Validate the URL
+	errs, err := govalidatorfieldnetwork.ValidateURLWithContext(ctx, url, options)
+	if err != nil {
+		return err
 	}
+	for _, validationErr := range errs {
+		validations.AddFieldValidationError(urlField, validationErr)
+	}
+	return nil
 }
 
-// Password validates the password field
+// Hostname validates the hostname field
 //
 // Parameters:
 //
-// - passwordField: the password field name
-// - password: the password to validate
-// - validations: the struct validations
-func (d *DefaultService) Password(
-	passwordField string,
-	password string,
+//   - hostnameField: the hostname field name
+//   - hostname: the hostname to validate
+//   - validations: the struct validations
+func (d *DefaultService) Hostname(
+	hostnameField string,
+	hostname string,
 	validations *govalidatormappervalidation.StructValidations,
 ) {
 	if d == nil {
 		return
 	}
 
-	// Check if the password length is less than the minimum length
-	if d.passwordOptions.MinimumLength > 0 && len(password) < d.passwordOptions.MinimumLength {
-		validations.AddFieldValidationError(
-			passwordField,
-			fmt.Errorf(
-				govalidatorfieldpassword.ErrMinimumLength,
-				d.passwordOptions.MinimumLength,
-			),
-		)
-	}
-
-	// Check if the password contains the minimum special characters
-	if d.passwordOptions.MinimumSpecialCount > 0 {
-		if count := gostringscount.Special(password); count < d.passwordOptions.MinimumSpecialCount {
-			validations.AddFieldValidationError(
-				passwordField,
-				fmt.Errorf(
-					govalidatorfieldpassword.ErrMinimumSpecialCount,
-					d.passwordOptions.MinimumSpecialCount,
-				),
-			)
-		}
+	// Validate the hostname
+	for _, err := range govalidatorfieldnetwork.ValidateHostname(hostname) {
+		validations.AddFieldValidationError(hostnameField, err)
 	}
+}
 
-	// Check if the password contains the minimum numbers
-	if d.passwordOptions.MinimumNumbersCount > 0 {
-		if count := gostringscount.Numbers(password); count < d.passwordOptions.MinimumNumbersCount {
-			validations.AddFieldValidationError(
-				passwordField,
-				fmt.Errorf(
-					govalidatorfieldpassword.ErrMinimumNumbersCount,
-					d.passwordOptions.MinimumNumbersCount,
-				),
-			)
-		}
+// IP validates the IP address field
+//
+// Parameters:
+//
+//   - ipField: the IP address field name
+//   - ip: the IP address to validate
+//   - family: the allowed IP address families, if zero every family is allowed
+//   - validations: the struct validations
+func (d *DefaultService) IP(
+	ipField string,
+	ip string,
+	family IPFamily,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
 	}
 
-	// Check if the password contains the minimum caps
-	if d.passwordOptions.MinimumCapsCount > 0 {
-		if count := gostringscount.Caps(password); count < d.passwordOptions.MinimumCapsCount {
-			validations.AddFieldValidationError(
-				passwordField,
-				fmt.Errorf(
-					govalidatorfieldpassword.ErrMinimumCapsCount,
-					d.passwordOptions.MinimumCapsCount,
-				),
-			)
-		}
+	// Validate the IP address
+	for _, err := range govalidatorfieldnetwork.ValidateIP(ip, family) {
+		validations.AddFieldValidationError(ipField, err)
 	}
 }
 
-// CreateValidateFn creates a validate function for a given mapper
+// CIDR validates the CIDR prefix field
 //
 // Parameters:
 //
-//   - mapper: the mapper to use
-//   - cache: whether to cache the validate function or not
-//   - auxiliaryValidatorFns: the auxiliary validator functions to use
+//   - cidrField: the CIDR prefix field name
+//   - cidr: the CIDR prefix to validate
+//   - options: the CIDR prefix options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) CIDR(
+	cidrField string,
+	cidr string,
+	options *CIDROptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the CIDR prefix
+	for _, err := range govalidatorfieldnetwork.ValidateCIDR(cidr, options) {
+		validations.AddFieldValidationError(cidrField, err)
+	}
+}
+
+// Port validates the port field
 //
-// Returns:
+// Parameters:
 //
-//   - ValidateFn: the validate function
-//   - error: if there was an error creating the validate function
-func (d *DefaultService) CreateValidateFn(
-	mapper *govalidatormapper.Mapper,
-	cache bool,
-	auxiliaryValidatorFns ...any,
-) (
-	ValidateFn, error,
+//   - portField: the port field name
+//   - port: the port to validate
+//   - validations: the struct validations
+func (d *DefaultService) Port(
+	portField string,
+	port int,
+	validations *govalidatormappervalidation.StructValidations,
 ) {
 	if d == nil {
-		return nil, ErrNilService
+		return
 	}
 
-	// Check if the mapper is nil
-	if mapper == nil {
-		return nil, govalidatormapper.ErrNilMapper
+	// Validate the port
+	for _, err := range govalidatorfieldnetwork.ValidatePort(port) {
+		validations.AddFieldValidationError(portField, err)
 	}
+}
 
-	// Check if the cache parameter is true, if so call try to get the validate function from the cache
-	if cache && d.validateFns != nil {
-		if validateFn, ok := d.validateFns[goreflect.UniqueTypeReference(mapper.GetStructInstance())]; ok {
-			return validateFn, nil
-		}
+// Card validates the card number field
+//
+// Parameters:
+//
+//   - cardField: the card number field name
+//   - card: the card number to validate
+//   - options: the card number options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Card(
+	cardField string,
+	card string,
+	options *CardOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
 	}
 
-	// Create the validate function
-	validateFn := func(
-		toValidate any,
-	) (
-		any,
-		error,
-	) {
-		// Check if the destination is a pointer
-		if toValidate == nil {
-			return nil, ErrNilDestination
-		}
-		if reflect.TypeOf(toValidate).Kind() != reflect.Ptr {
-			return nil, ErrDestinationNotPointer
-		}
+	// Validate the card number
+	for _, err := range govalidatorfieldfinance.ValidateCard(card, options) {
+		validations.AddFieldValidationError(cardField, err)
+	}
+}
 
-		// Initialize struct fields validations from the request body
-		rootStructValidations, err := govalidatormappervalidation.NewStructValidations(toValidate)
-		if err != nil {
-			return nil, err
-		}
+// IBAN validates the IBAN field
+//
+// Parameters:
+//
+//   - ibanField: the IBAN field name
+//   - iban: the IBAN to validate
+//   - validations: the struct validations
+func (d *DefaultService) IBAN(
+	ibanField string,
+	iban string,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
 
-		// Validate the required fields
-		if valErr := d.ValidateRequiredFields(
-			rootStructValidations,
-			mapper,
-		); valErr != nil {
-			return nil, valErr
-		}
+	// Validate the IBAN
+	for _, err := range govalidatorfieldfinance.ValidateIBAN(iban) {
+		validations.AddFieldValidationError(ibanField, err)
+	}
+}
 
-		// Call the validate function
-		for _, auxiliaryValidatorFn := range auxiliaryValidatorFns {
-			_, err = goreflect.SafeCallFunction(
-				auxiliaryValidatorFn,
-				toValidate,
-				rootStructValidations,
-			)
-			if err != nil {
-				if d.logger != nil {
-					d.logger.Error(
-						"Error calling auxiliary validator function",
-						slog.String("error", err.Error()),
-					)
-				}
-				return nil, err
-			}
-		}
+// BIC validates the BIC field
+//
+// Parameters:
+//
+//   - bicField: the BIC field name
+//   - bic: the BIC to validate
+//   - validations: the struct validations
+func (d *DefaultService) BIC(
+	bicField string,
+	bic string,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
 
-		// Parse the validations
-		return d.ParseValidations(rootStructValidations)
+	// Validate the BIC
+	for _, err := range govalidatorfieldfinance.ValidateBIC(bic) {
+		validations.AddFieldValidationError(bicField, err)
 	}
+}
 
-	// If cache is true, cache the validate function
-	if cache {
-		if d.validateFns == nil {
-			d.validateFns = make(map[string]ValidateFn)
-		}
-		d.validateFns[goreflect.UniqueTypeReference(mapper.GetStructInstance())] = validateFn
+// Currency validates the currency code field
+//
+// Parameters:
+//
+//   - currencyField: the currency code field name
+//   - currency: the currency code to validate
+//   - validations: the struct validations
+func (d *DefaultService) Currency(
+	currencyField string,
+	currency string,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
 	}
 
-	return validateFn, nil
+	// Validate the currency code
+	for _, err := range govalidatorfieldfinance.ValidateCurrency(currency) {
+		validations.AddFieldValidationError(currencyField, err)
+	}
 }
 
-// Validate is the function that creates (if not cached), caches and executes the validation
+// UUID validates the UUID field
 //
 // Parameters:
 //
-//   - mapper: the mapper to use
-//   - auxiliaryValidatorFns: auxiliary validator functions to use in the validation
+//   - uuidField: the UUID field name
+//   - uuid: the UUID to validate
+//   - options: the UUID options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) UUID(
+	uuidField string,
+	uuid string,
+	options *UUIDOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the UUID
+	for _, err := range govalidatorfieldidentifier.ValidateUUID(uuid, options) {
+		validations.AddFieldValidationError(uuidField, err)
+	}
+}
+
+// ULID validates the ULID field
 //
-// Returns:
+// Parameters:
 //
-//   - any: the parsed validations
-//   - error: if there was an error validating the request
-func (d *DefaultService) Validate(
-	mapper *govalidatormapper.Mapper,
-	auxiliaryValidatorFns ...any,
-) (any, error) {
+//   - ulidField: the ULID field name
+//   - ulid: the ULID to validate
+//   - window: the time window of the ULID timestamp (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) ULID(
+	ulidField string,
+	ulid string,
+	window *TimeWindow,
+	validations *govalidatormappervalidation.StructValidations,
+) {
 	if d == nil {
-		return nil, ErrNilService
+		return
 	}
 
-	// Create and cache the validate function
-	validateFn, err := d.CreateValidateFn(
-		mapper,
-		true,
-		auxiliaryValidatorFns...,
-	)
-	if err != nil {
-		return nil, err
+	// Validate the ULID
+	for _, err := range govalidatorfieldidentifier.ValidateULID(ulid, window) {
+		validations.AddFieldValidationError(ulidField, err)
+	}
+}
+
+// KSUID validates the KSUID field
+//
+// Parameters:
+//
+//   - ksuidField: the KSUID field name
+//   - ksuid: the KSUID to validate
+//   - window: the time window of the KSUID timestamp (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) KSUID(
+	ksuidField string,
+	ksuid string,
+	window *TimeWindow,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the KSUID
+	for _, err := range govalidatorfieldidentifier.ValidateKSUID(ksuid, window) {
+		validations.AddFieldValidationError(ksuidField, err)
+	}
+}
+
+// Snowflake validates the snowflake ID field
+//
+// Parameters:
+//
+//   - snowflakeField: the snowflake ID field name
+//   - snowflake: the snowflake ID to validate
+//   - options: the snowflake ID options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Snowflake(
+	snowflakeField string,
+	snowflake string,
+	options *SnowflakeOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the snowflake ID
+	for _, err := range govalidatorfieldidentifier.ValidateSnowflake(snowflake, options) {
+		validations.AddFieldValidationError(snowflakeField, err)
+	}
+}
+
+// Country validates the ISO 3166-1 alpha-2 country code field
+//
+// Parameters:
+//
+//   - countryField: the ISO 3166-1 alpha-2 country code field name
+//   - country: the ISO 3166-1 alpha-2 country code to validate
+//   - options: the ISO reference data options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Country(
+	countryField string,
+	country string,
+	options *ISOOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the ISO 3166-1 alpha-2 country code
+	for _, err := range govalidatorfieldiso.ValidateCountryAlpha2(country, options) {
+		validations.AddFieldValidationError(countryField, err)
+	}
+}
+
+// Subdivision validates the ISO 3166-2 subdivision code field
+//
+// Parameters:
+//
+//   - subdivisionField: the ISO 3166-2 subdivision code field name
+//   - subdivision: the ISO 3166-2 subdivision code to validate
+//   - options: the ISO reference data options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Subdivision(
+	subdivisionField string,
+	subdivision string,
+	options *ISOOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the ISO 3166-2 subdivision code
+	for _, err := range govalidatorfieldiso.ValidateSubdivision(subdivision, options) {
+		validations.AddFieldValidationError(subdivisionField, err)
+	}
+}
+
+// Language validates the ISO 639-1 language code field
+//
+// Parameters:
+//
+//   - languageField: the ISO 639-1 language code field name
+//   - language: the ISO 639-1 language code to validate
+//   - options: the ISO reference data options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Language(
+	languageField string,
+	language string,
+	options *ISOOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the ISO 639-1 language code
+	for _, err := range govalidatorfieldiso.ValidateLanguageAlpha2(language, options) {
+		validations.AddFieldValidationError(languageField, err)
+	}
+}
+
+// Locale validates the BCP 47 locale field
+//
+// Parameters:
+//
+//   - localeField: the BCP 47 locale field name
+//   - locale: the BCP 47 locale to validate
+//   - options: the ISO reference data options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Locale(
+	localeField string,
+	locale string,
+	options *ISOOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the BCP 47 locale
+	for _, err := range govalidatorfieldiso.ValidateLocale(locale, options) {
+		validations.AddFieldValidationError(localeField, err)
+	}
+}
+
+// Timezone validates the IANA timezone field
+//
+// Parameters:
+//
+//   - timezoneField: the IANA timezone field name
+//   - timezone: the IANA timezone to validate
+//   - options: the ISO reference data options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Timezone(
+	timezoneField string,
+	timezone string,
+	options *ISOOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the IANA timezone
+	for _, err := range govalidatorfieldiso.ValidateTimezone(timezone, options) {
+		validations.AddFieldValidationError(timezoneField, err)
+	}
+}
+
+// NationalID validates the national identity or tax document number field
+//
+// Parameters:
+//
+//   - nationalIDField: the document number field name
+//   - nationalID: the document number to validate
+//   - country: the ISO 3166-1 alpha-2 country code of the document, e.g. taken from a sibling field
+//   - documentType: the document type (optional, if empty any document type of the country is accepted)
+//   - validations: the struct validations
+func (d *DefaultService) NationalID(
+	nationalIDField string,
+	nationalID string,
+	country string,
+	documentType NationalIDDocumentType,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the document number with the validator registered for the country and the document type
+	for _, err := range govalidatorfieldnationalid.Validate(
+		country,
+		documentType,
+		nationalID,
+	) {
+		validations.AddFieldValidationError(nationalIDField, err)
+	}
+}
+
+// Number validates the numeric field
+//
+// Parameters:
+//
+//   - numberField: the numeric field name
+//   - number: the number to validate, either an integer, a float, a json.Number, a decimal string or a big number
+//   - options: the numeric constraints (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Number(
+	numberField string,
+	number any,
+	options *NumberOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the number
+	for _, err := range govalidatorfieldnumber.Validate(number, options) {
+		validations.AddFieldValidationError(numberField, err)
+	}
+}
+
+// Text validates the free-text field
+//
+// Parameters:
+//
+//   - textField: the free-text field name
+//   - text: the text to validate
+//   - options: the free-text policy (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Text(
+	textField string,
+	text string,
+	options *TextOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the text
+	for _, err := range govalidatorfieldtext.Validate(text, options) {
+		validations.AddFieldValidationError(textField, err)
+	}
+}
+
+// Semver validates the semantic version field
+//
+// Parameters:
+//
+//   - semverField: the semantic version field name
+//   - semver: the semantic version to validate
+//   - validations: the struct validations
+func (d *DefaultService) Semver(
+	semverField string,
+	semver string,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the semantic version
+	for _, err := range govalidatorfieldformat.ValidateSemver(semver) {
+		validations.AddFieldValidationError(semverField, err)
+	}
+}
+
+// Cron validates the cron expression field
+//
+// Parameters:
+//
+//   - cronField: the cron expression field name
+//   - cron: the cron expression to validate
+//   - options: the cron expression options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Cron(
+	cronField string,
+	cron string,
+	options *CronOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the cron expression
+	for _, err := range govalidatorfieldformat.ValidateCron(cron, options) {
+		validations.AddFieldValidationError(cronField, err)
+	}
+}
+
+// Regex validates the regular expression field
+//
+// Parameters:
+//
+//   - regexField: the regular expression field name
+//   - regex: the regular expression to validate
+//   - options: the regular expression options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Regex(
+	regexField string,
+	regex string,
+	options *RegexOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the regular expression
+	for _, err := range govalidatorfieldformat.ValidateRegex(regex, options) {
+		validations.AddFieldValidationError(regexField, err)
+	}
+}
+
+// JSONPointer validates the JSON pointer field
+//
+// Parameters:
+//
+//   - jsonPointerField: the JSON pointer field name
+//   - jsonPointer: the JSON pointer to validate
+//   - validations: the struct validations
+func (d *DefaultService) JSONPointer(
+	jsonPointerField string,
+	jsonPointer string,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the JSON pointer
+	for _, err := range govalidatorfieldformat.ValidateJSONPointer(jsonPointer) {
+		validations.AddFieldValidationError(jsonPointerField, err)
+	}
+}
+
+// MIMEType validates the MIME type field
+//
+// Parameters:
+//
+//   - mimeTypeField: the MIME type field name
+//   - mimeType: the MIME type to validate
+//   - options: the MIME type options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) MIMEType(
+	mimeTypeField string,
+	mimeType string,
+	options *MIMEOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the MIME type
+	for _, err := range govalidatorfieldformat.ValidateMIMEType(mimeType, options) {
+		validations.AddFieldValidationError(mimeTypeField, err)
+	}
+}
+
+// File validates the file field
+//
+// Parameters:
+//
+//   - fileField: the file field name
+//   - file: the file to validate, either a *multipart.FileHeader, a []*multipart.FileHeader or a []byte
+//   - options: the file upload policy (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) File(
+	fileField string,
+	file any,
+	options *FileOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the file
+	for _, err := range govalidatorfieldfile.Validate(file, options) {
+		validations.AddFieldValidationError(fileField, err)
+	}
+}
+
+// GeoPoint validates the latitude and longitude fields of a geographic point, the errors of the allowed areas are
+// added to the latitude field
+//
+// Parameters:
+//
+//   - latitudeField: the latitude field name
+//   - longitudeField: the longitude field name
+//   - latitude: the latitude to validate
+//   - longitude: the longitude to validate
+//   - options: the geographic point options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) GeoPoint(
+	latitudeField string,
+	longitudeField string,
+	latitude float64,
+	longitude float64,
+	options *GeoOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the geographic point
+	latitudeErrs, longitudeErrs, pointErrs := govalidatorfieldgeo.ValidateCoordinates(
+		govalidatorfieldgeo.Point{Latitude: latitude, Longitude: longitude},
+		options,
+	)
+	for _, err := range append(latitudeErrs, pointErrs...) {
+		validations.AddFieldValidationError(latitudeField, err)
+	}
+	for _, err := range longitudeErrs {
+		validations.AddFieldValidationError(longitudeField, err)
+	}
+}
+
+// Secrets checks the free-text field for sensitive values, such as API keys, card numbers and email addresses
+//
+// Parameters:
+//
+//   - textField: the free-text field name
+//   - text: the text to check
+//   - options: the sensitive data detection options (optional, can be nil)
+//   - validations: the struct validations
+func (d *DefaultService) Secrets(
+	textField string,
+	text string,
+	options *SecretsOptions,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Check the text for sensitive values
+	for _, err := range govalidatorfieldsecrets.Validate(text, options) {
+		validations.AddFieldValidationError(textField, err)
+	}
+}
+
+// EmailWithContext validates the email address field, including the checks that require the context such as the
+// domain deliverability
+//
+// Parameters:
+//
+//   - ctx: the context
+//   - emailField: the email field name
+//   - email: the email to validate
+//   - validations: the struct validations
+//
+// Returns:
+//
+//   - error: if the context was canceled during the validation
+func (d *DefaultService) EmailWithContext(
+	ctx context.Context,
+	emailField string,
+	email string,
+	validations *govalidatormappervalidation.StructValidations,
+) error {
+	if d == nil {
+		return ErrNilService
+	}
+
+	// Validate the mail address
+	errs, err := govalidatorfieldmail.ValidateWithContext(
+		ctx,
+		email,
+		d.emailOptions,
+	)
+	if err != nil {
+		return err
+	}
+	for _, validationErr := range errs {
+		validations.AddFieldValidationError(emailField, validationErr)
+	}
+	return nil
+}
+
+// Birthdate validates the birthdate field
+//
+// Parameters:
+//
+// - birthdateField: the birthdate field name
+// - birthdate: the birthdate to validate
+// - validations: the struct validations
+func (d *DefaultService) Birthdate(
+	birthdateField string,
+	birthdate time.Time,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the birthdate against the current date of the clock
+	for _, err := range govalidatorfieldbirthdate.Validate(
+		birthdate,
+		d.clock.Now(),
+		d.birthdateOptions,
+	) {
+		validations.AddFieldValidationError(birthdateField, err)
+	}
+}
+
+// CivilBirthdate validates a birthdate field given as a civil date, the parse errors are reported as field violations
+//
+// Parameters:
+//
+// - birthdateField: the birthdate field name
+// - birthdate: the birthdate to validate, either a time.Time, a civil date string in the YYYY-MM-DD format, a
+// *timestamppb.Timestamp or a civil date message such as google.type.Date
+// - validations: the struct validations
+func (d *DefaultService) CivilBirthdate(
+	birthdateField string,
+	birthdate any,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Convert the civil date to a time
+	parsedBirthdate, ok := d.parseCivilBirthdate(birthdateField, birthdate, validations)
+	if !ok {
+		return
+	}
+	d.Birthdate(birthdateField, parsedBirthdate, validations)
+}
+
+// CivilBirthdateWithJurisdiction validates a birthdate field given as a civil date, including the age rule of the
+// given jurisdiction
+//
+// Parameters:
+//
+// - birthdateField: the birthdate field name
+// - birthdate: the birthdate to validate, either a time.Time, a civil date string in the YYYY-MM-DD format, a
+// *timestamppb.Timestamp or a civil date message such as google.type.Date
+// - jurisdiction: the jurisdiction code, e.g. 'US' or 'DE'
+// - validations: the struct validations
+func (d *DefaultService) CivilBirthdateWithJurisdiction(
+	birthdateField string,
+	birthdate any,
+	jurisdiction string,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Convert the civil date to a time
+	parsedBirthdate, ok := d.parseCivilBirthdate(birthdateField, birthdate, validations)
+	if !ok {
+		return
+	}
+	d.BirthdateWithJurisdiction(birthdateField, parsedBirthdate, jurisdiction, validations)
+}
+
+// parseCivilBirthdate converts a birthdate given as a civil date to a time, the timestamps are read in the reference
+// timezone of the birthdate options
+//
+// Parameters:
+//
+// - birthdateField: the birthdate field name
+// - birthdate: the birthdate to convert
+// - validations: the struct validations
+//
+// Returns:
+//
+// - time.Time: the birthdate time
+// - bool: true if the birthdate was converted, false if the error was added to the validations
+func (d *DefaultService) parseCivilBirthdate(
+	birthdateField string,
+	birthdate any,
+	validations *govalidatormappervalidation.StructValidations,
+) (time.Time, bool) {
+	var loc *time.Location
+	if d.birthdateOptions != nil {
+		loc = d.birthdateOptions.Location
+	}
+
+	parsedBirthdate, err := govalidatorfieldbirthdate.ToTime(birthdate, loc)
+	if err != nil {
+		validations.AddFieldValidationError(birthdateField, err)
+		return time.Time{}, false
+	}
+	return parsedBirthdate, true
+}
+
+// BirthdateWithJurisdiction validates the birthdate field, including the age rule of the given jurisdiction, which is
+// usually taken from a sibling field such as the country
+//
+// Parameters:
+//
+// - birthdateField: the birthdate field name
+// - birthdate: the birthdate to validate
+// - jurisdiction: the jurisdiction code, e.g. 'US' or 'DE'
+// - validations: the struct validations
+func (d *DefaultService) BirthdateWithJurisdiction(
+	birthdateField string,
+	birthdate time.Time,
+	jurisdiction string,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the birthdate against the global options
+	d.Birthdate(birthdateField, birthdate, validations)
+
+	// Get the jurisdictions table and the reference timezone
+	var table govalidatorfieldbirthdate.JurisdictionTable
+	var loc *time.Location
+	if d.birthdateOptions != nil {
+		table = d.birthdateOptions.Jurisdictions
+		loc = d.birthdateOptions.Location
+	}
+
+	// Validate the birthdate against the jurisdiction age rule
+	for _, err := range govalidatorfieldbirthdate.ValidateJurisdiction(
+		birthdate,
+		d.clock.Now(),
+		jurisdiction,
+		table,
+		loc,
+	) {
+		validations.AddFieldValidationError(birthdateField, err)
+	}
+}
+
+// BirthdateWithContext validates the birthdate field, including the age rule of the jurisdiction set in the context
+// with govalidatorfieldbirthdate.WithJurisdiction, if any
+//
+// Parameters:
+//
+// - ctx: the context
+// - birthdateField: the birthdate field name
+// - birthdate: the birthdate to validate
+// - validations: the struct validations
+//
+// Returns:
+//
+// - error: if the context was canceled
+func (d *DefaultService) BirthdateWithContext(
+	ctx context.Context,
+	birthdateField string,
+	birthdate time.Time,
+	validations *govalidatormappervalidation.StructValidations,
+) error {
+	if d == nil {
+		return ErrNilService
+	}
+
+	// Check if the context was canceled
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Get the jurisdiction from the context
+	jurisdiction, _ := govalidatorfieldbirthdate.JurisdictionFromContext(ctx)
+	d.BirthdateWithJurisdiction(
+		birthdateField,
+		birthdate,
+		jurisdiction,
+		validations,
+	)
+	return nil
+}
+
+// Password validates the password field
+//
+// Parameters:
+//
+// - passwordField: the password field name
+// - password: the password to validate
+// - validations: the struct validations
+func (d *DefaultService) Password(
+	passwordField string,
+	password string,
+	validations *govalidatormappervalidation.StructValidations,
+) {
+	if d == nil {
+		return
+	}
+
+	// Validate the password against the password policy
+	for _, err := range govalidatorfieldpassword.Validate(
+		password,
+		d.passwordOptions,
+	) {
+		validations.AddFieldValidationError(passwordField, err)
+	}
+}
+
+// PasswordWithContext validates the password field and checks it against the password history of the user
+//
+// Parameters:
+//
+// - ctx: the context
+// - passwordField: the password field name
+// - userID: the ID of the user that owns the password
+// - password: the password to validate
+// - validations: the struct validations
+//
+// Returns:
+//
+// - error: if there was an error checking the password history
+func (d *DefaultService) PasswordWithContext(
+	ctx context.Context,
+	passwordField string,
+	userID string,
+	password string,
+	validations *govalidatormappervalidation.StructValidations,
+) error {
+	if d == nil {
+		return ErrNilService
+	}
+
+	// Validate the password policy
+	d.Password(passwordField, password, validations)
+
+	// Check if the password history checker is set
+	if d.passwordOptions == nil || d.passwordOptions.HistoryChecker == nil {
+		return nil
+	}
+
+	// Check if the password was recently used
+	isReused, err := d.passwordOptions.HistoryChecker.IsReused(
+		ctx,
+		userID,
+		password,
+	)
+	if err != nil {
+		return err
+	}
+	if isReused {
+		validations.AddFieldValidationError(
+			passwordField,
+			govalidatorfieldpassword.ErrReused,
+		)
+	}
+	return nil
+}
+
+// CreateValidateFn creates a validate function for a given mapper
+//
+// Parameters:
+//
+//   - mapper: the mapper to use
+//   - cache: whether to cache the validate function or not
+//   - auxiliaryValidatorFns: the auxiliary validator functions to use, the ones that take a context.Context as their
+//     first parameter are called with context.Background(), and their returned error stops the validation
+//
+// Returns:
+//
+//   - ValidateFn: the validate function
+//   - error: if there was an error creating the validate function
+func (d *DefaultService) CreateValidateFn(
+	mapper *govalidatormapper.Mapper,
+	cache bool,
+	auxiliaryValidatorFns ...any,
+) (
+	ValidateFn, error,
+) {
+	if d == nil {
+		return nil, ErrNilService
+	}
+
+	// Check if the mapper is nil
+	if mapper == nil {
+		return nil, govalidatormapper.ErrNilMapper
+	}
+
+	// Check if the cache parameter is true, if so call try to get the validate function from the cache
+	if cache && d.validateFns != nil {
+		if validateFn, ok := d.validateFns[goreflect.UniqueTypeReference(mapper.GetStructInstance())]; ok {
+			return validateFn, nil
+		}
+	}
+
+	// Create the validate function
+	validateWithContextFn := d.newValidateWithContextFn(mapper, auxiliaryValidatorFns)
+	validateFn := func(
+		toValidate any,
+	) (
+		any,
+		error,
+	) {
+		return validateWithContextFn(context.Background(), toValidate)
+	}
+
+	// If cache is true, cache the validate function
+	if cache {
+		if d.validateFns == nil {
+			d.validateFns = make(map[string]ValidateFn)
+		}
+		d.validateFns[goreflect.UniqueTypeReference(mapper.GetStructInstance())] = validateFn
+	}
+
+	return validateFn, nil
+}
+
+// CreateValidateWithContextFn creates a context-aware validate function for a given mapper, whose context is passed to
+// the auxiliary validator functions, so they can run the validations that call external services, like
+// EmailWithContext for the domain deliverability
+//
+// Parameters:
+//
+//   - mapper: the mapper to use
+//   - cache: whether to cache the validate function or not
+//   - auxiliaryValidatorFns: the auxiliary validator functions to use, the ones that take a context.Context as their
+//     first parameter are called with the context of the validation, and their returned error stops the validation
+//
+// Returns:
+//
+//   - ValidateWithContextFn: the validate function
+//   - error: if there was an error creating the validate function
+func (d *DefaultService) CreateValidateWithContextFn(
+	mapper *govalidatormapper.Mapper,
+	cache bool,
+	auxiliaryValidatorFns ...any,
+) (
+	ValidateWithContextFn, error,
+) {
+	if d == nil {
+		return nil, ErrNilService
+	}
+
+	// Check if the mapper is nil
+	if mapper == nil {
+		return nil, govalidatormapper.ErrNilMapper
+	}
+
+	// Check if the cache parameter is true, if so call try to get the validate function from the cache
+	if cache && d.validateWithContextFns != nil {
+		if validateFn, ok := d.validateWithContextFns[goreflect.UniqueTypeReference(mapper.GetStructInstance())]; ok {
+			return validateFn, nil
+		}
+	}
+
+	// Create the validate function
+	validateFn := d.newValidateWithContextFn(mapper, auxiliaryValidatorFns)
+
+	// If cache is true, cache the validate function
+	if cache {
+		if d.validateWithContextFns == nil {
+			d.validateWithContextFns = make(map[string]ValidateWithContextFn)
+		}
+		d.validateWithContextFns[goreflect.UniqueTypeReference(mapper.GetStructInstance())] = validateFn
+	}
+
+	return validateFn, nil
+}
+
+// newValidateWithContextFn creates the context-aware validate function for a given mapper
+//
+// Parameters:
+//
+//   - mapper: the mapper to use
+//   - auxiliaryValidatorFns: the auxiliary validator functions to use
+//
+// Returns:
+//
+//   - ValidateWithContextFn: the validate function
+func (d *DefaultService) newValidateWithContextFn(
+	mapper *govalidatormapper.Mapper,
+	auxiliaryValidatorFns []any,
+) ValidateWithContextFn {
+	return func(
+		ctx context.Context,
+		toValidate any,
+	) (
+		any,
+		error,
+	) {
+		// Check if the destination is a pointer
+		if toValidate == nil {
+			return nil, ErrNilDestination
+		}
+		if reflect.TypeOf(toValidate).Kind() != reflect.Ptr {
+			return nil, ErrDestinationNotPointer
+		}
+
+		// Initialize struct fields validations from the request body
+		rootStructValidations, err := govalidatormappervalidation.NewStructValidations(toValidate)
+		if err != nil {
+			return nil, err
+		}
+
+		// Validate the required fields
+		if valErr := d.ValidateRequiredFields(
+			rootStructValidations,
+			mapper,
+		); valErr != nil {
+			return nil, valErr
+		}
+
+		// Call the validate function, passing the context to the context-aware ones
+		for _, auxiliaryValidatorFn := range auxiliaryValidatorFns {
+			if isContextFunction(auxiliaryValidatorFn) {
+				err = callContextFunction(
+					auxiliaryValidatorFn,
+					ctx,
+					toValidate,
+					rootStructValidations,
+				)
+			} else {
+				_, err = goreflect.SafeCallFunction(
+					auxiliaryValidatorFn,
+					toValidate,
+					rootStructValidations,
+				)
+			}
+			if err != nil {
+				if d.logger != nil {
+					d.logger.Error(
+						"Error calling auxiliary validator function",
+						slog.String("error", err.Error()),
+					)
+				}
+				return nil, err
+			}
+		}
+
+		// Parse the validations
+		return d.ParseValidations(rootStructValidations)
+	}
+}
+
+// Validate is the function that creates (if not cached), caches and executes the validation
+//
+// Parameters:
+//
+//   - mapper: the mapper to use
+//   - auxiliaryValidatorFns: auxiliary validator functions to use in the validation
+//
+// Returns:
+//
+//   - any: the parsed validations
+//   - error: if there was an error validating the request
+func (d *DefaultService) Validate(
+	mapper *govalidatormapper.Mapper,
+	auxiliaryValidatorFns ...any,
+) (any, error) {
+	if d == nil {
+		return nil, ErrNilService
+	}
+
+	// Create and cache the validate function
+	validateFn, err := d.CreateValidateFn(
+		mapper,
+		true,
+		auxiliaryValidatorFns...,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	// Execute the validate function
 	return validateFn(mapper)
 }
+
+// ValidateWithContext is the function that creates (if not cached), caches and executes the context-aware validation
+//
+// Parameters:
+//
+//   - ctx: the context of the validation, passed to the context-aware auxiliary validator functions
+//   - mapper: the mapper to use
+//   - toValidate: the pointer to the struct to validate
+//   - auxiliaryValidatorFns: auxiliary validator functions to use in the validation
+//
+// Returns:
+//
+//   - any: the parsed validations
+//   - error: if there was an error validating the request, or the context error if it was canceled
+func (d *DefaultService) ValidateWithContext(
+	ctx context.Context,
+	mapper *govalidatormapper.Mapper,
+	toValidate any,
+	auxiliaryValidatorFns ...any,
+) (any, error) {
+	if d == nil {
+		return nil, ErrNilService
+	}
+
+	// Create and cache the validate function
+	validateFn, err := d.CreateValidateWithContextFn(
+		mapper,
+		true,
+		auxiliaryValidatorFns...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the validate function
+	return validateFn(ctx, toValidate)
+}
+
+// isContextFunction checks if a function takes a context.Context as its first parameter
+//
+// Parameters:
+//
+//   - fn: the function to check
+//
+// Returns:
+//
+//   - bool: true if the function takes a context as its first parameter, false otherwise
+func isContextFunction(fn any) bool {
+	fnType := reflect.TypeOf(fn)
+	return fnType != nil && fnType.Kind() == reflect.Func && fnType.NumIn() > 0 &&
+		fnType.In(0) == reflect.TypeFor[context.Context]()
+}
+
+// callContextFunction calls a function whose first parameter is a context.Context, returning its error result, if
+// any, so the context errors stop the validation
+//
+// Parameters:
+//
+//   - fn: the function to call
+//   - ctx: the context to pass to the function
+//   - params: the other parameters to pass to the function
+//
+// Returns:
+//
+//   - error: if the function is not valid for the parameters, or the error returned by the function
+func callContextFunction(fn any, ctx context.Context, params ...any) error {
+	// Check if the function has the correct number of parameters
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+	if fnType.NumIn() != len(params)+1 {
+		return fmt.Errorf(goreflect.ErrFunctionParameterCountMismatch, fnType.NumIn(), len(params)+1)
+	}
+
+	// Check if the parameter types match the function's parameter types, the context is passed as an interface
+	paramsValues := make([]reflect.Value, 0, len(params)+1)
+	ctxValue := reflect.New(fnType.In(0)).Elem()
+	ctxValue.Set(reflect.ValueOf(ctx))
+	paramsValues = append(paramsValues, ctxValue)
+	for i, param := range params {
+		paramValue := reflect.ValueOf(param)
+		if paramValue.Type() != fnType.In(i+1) {
+			return fmt.Errorf(goreflect.ErrFunctionParameterTypeMismatch, i+1, fnType.In(i+1), paramValue.Type())
+		}
+		paramsValues = append(paramsValues, paramValue)
+	}
+
+	// Call the function and get its error result
+	results, err := goreflect.UnsafeCallFunction(&fnValue, paramsValues...)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if resultErr, ok := result.(error); ok && resultErr != nil {
+			return resultErr
+		}
+	}
+	return nil
+}
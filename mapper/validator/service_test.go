@@ -0,0 +1,126 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	govalidatorfieldmail "github.com/ralvarezdev/go-validator/field/mail"
+	govalidatormapper "github.com/ralvarezdev/go-validator/mapper"
+	govalidatormapperparser "github.com/ralvarezdev/go-validator/mapper/parser"
+	govalidatormapperparserjson "github.com/ralvarezdev/go-validator/mapper/parser/json"
+	govalidatormappervalidation "github.com/ralvarezdev/go-validator/mapper/validation"
+)
+
+// newTestService creates a validator service with the given email options
+func newTestService(t *testing.T, emailOptions *EmailOptions) *DefaultService {
+	service, err := NewDefaultService(
+		govalidatormapperparser.NewDefaultRawParser(nil),
+		govalidatormapperparserjson.NewDefaultEndParser(),
+		NewDefaultValidator(nil),
+		&ServiceOptions{Email: emailOptions},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("NewDefaultService returned error: %v", err)
+	}
+	return service
+}
+
+func TestValidateWithContextRunsDeliverability(t *testing.T) {
+	type request struct {
+		Email string `json:"email"`
+	}
+
+	resolver := govalidatorfieldmail.NewMemoryResolver()
+	resolver.AddMX("mx.example", "mail.mx.example.")
+	checker, err := govalidatorfieldmail.NewDeliverabilityChecker(resolver, nil)
+	if err != nil {
+		t.Fatalf("NewDeliverabilityChecker returned error: %v", err)
+	}
+	service := newTestService(t, &EmailOptions{Deliverability: checker})
+
+	mapper, err := govalidatormapper.NewJSONGenerator(nil).NewMapper(&request{})
+	if err != nil {
+		t.Fatalf("NewMapper returned error: %v", err)
+	}
+	validateEmail := func(
+		ctx context.Context,
+		toValidate *request,
+		validations *govalidatormappervalidation.StructValidations,
+	) error {
+		return service.EmailWithContext(ctx, "email", toValidate.Email, validations)
+	}
+
+	// A deliverable domain has no validations
+	result, err := service.ValidateWithContext(
+		context.Background(),
+		mapper,
+		&request{Email: "john@mx.example"},
+		validateEmail,
+	)
+	if err != nil || result != nil {
+		t.Errorf("ValidateWithContext(john@mx.example) = %v, %v, want nil, nil", result, err)
+	}
+
+	// An undeliverable domain fails the validation
+	result, err = service.ValidateWithContext(
+		context.Background(),
+		mapper,
+		&request{Email: "john@missing.example"},
+		validateEmail,
+	)
+	if err != nil || result == nil {
+		t.Errorf("ValidateWithContext(john@missing.example) = %v, %v, want the validations", result, err)
+	}
+
+	// The canceled context is passed to the auxiliary validator function
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = service.ValidateWithContext(
+		ctx,
+		mapper,
+		&request{Email: "john@other.example"},
+		validateEmail,
+	); !errors.Is(err, context.Canceled) {
+		t.Errorf("ValidateWithContext with a canceled context error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestCreateValidateFnCallsAuxiliaryValidatorFunctions(t *testing.T) {
+	type request struct {
+		Name string `json:"name"`
+	}
+
+	service := newTestService(t, nil)
+	mapper, err := govalidatormapper.NewJSONGenerator(nil).NewMapper(&request{})
+	if err != nil {
+		t.Fatalf("NewMapper returned error: %v", err)
+	}
+
+	var calls []string
+	validateFn, err := service.CreateValidateFn(
+		mapper,
+		false,
+		func(_ *request, _ *govalidatormappervalidation.StructValidations) error {
+			calls = append(calls, "without context")
+			return nil
+		},
+		func(ctx context.Context, _ *request, _ *govalidatormappervalidation.StructValidations) error {
+			if ctx == nil {
+				t.Error("auxiliary validator function got a nil context")
+			}
+			calls = append(calls, "with context")
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("CreateValidateFn returned error: %v", err)
+	}
+	if _, err = validateFn(&request{Name: "john"}); err != nil {
+		t.Fatalf("validate function returned error: %v", err)
+	}
+	if len(calls) != 2 || calls[0] != "without context" || calls[1] != "with context" {
+		t.Errorf("calls = %v, want [without context with context]", calls)
+	}
+}
@@ -1,6 +1,13 @@
 package validator
 
+import (
+	"context"
+)
+
 type (
 	// ValidateFn is the type for the validate function
 	ValidateFn func(toValidate any) (any, error)
+
+	// ValidateWithContextFn is the type for the context-aware validate function
+	ValidateWithContextFn func(ctx context.Context, toValidate any) (any, error)
 )
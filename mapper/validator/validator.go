@@ -5,11 +5,17 @@ import (
 	"log/slog"
 	"reflect"
 
+	govalidatorfieldfinance "github.com/ralvarezdev/go-validator/field/finance"
 	govalidatormapper "github.com/ralvarezdev/go-validator/mapper"
 	govalidatormappervalidation "github.com/ralvarezdev/go-validator/mapper/validation"
 	goreflect "github.com/ralvarezdev/go-reflect"
 )
 
+const (
+	// RedactedFieldValue is the value logged for the fields whose value cannot be redacted
+	RedactedFieldValue = "[REDACTED]"
+)
+
 type (
 	// DefaultValidator struct
 	DefaultValidator struct {
@@ -101,9 +107,27 @@ func (d DefaultValidator) ValidateRequiredFields(
 			*rootStructValidationsTypeReference,
 		)
 	}
+	return d.validateRequiredFields(rootStructValidations, mapper)
+}
 
+// validateRequiredFields validates the required fields of a root or nested struct
+//
+// Parameters:
+//
+//   - rootStructValidations: the struct validations
+//   - mapper: the mapper to use
+//
+// Returns:
+//
+//   - error: if there was an error validating the required fields
+func (d DefaultValidator) validateRequiredFields(
+	rootStructValidations *govalidatormappervalidation.StructValidations,
+	mapper *govalidatormapper.Mapper,
+) error {
 	// Check if the struct has fields validations
 	if !mapper.HasFieldsValidations() {
+		// Validate the struct-level rules
+		d.validateStructRules(rootStructValidations, mapper)
 		return nil
 	}
 
@@ -141,8 +165,14 @@ func (d DefaultValidator) ValidateRequiredFields(
 			fieldValueInterface := fieldValue.Interface()
 			if fieldValueInterface == nil {
 				valueStr = "nil"
+			} else if hasIntegerSequence(reflect.ValueOf(fieldValueInterface), 0) {
+				// Byte and integer sequences are formatted as lists of numbers, which cannot be redacted
+				valueStr = RedactedFieldValue
 			} else {
-				valueStr = fmt.Sprintf("%#v", fieldValueInterface)
+				// Redact the card numbers, so they are never logged
+				valueStr = govalidatorfieldfinance.RedactPANs(
+					fmt.Sprintf("%#v", fieldValueInterface),
+				)
 			}
 
 			if isInitialized {
@@ -166,8 +196,15 @@ func (d DefaultValidator) ValidateRequiredFields(
 			}
 		}
 
-		// Check if the field has to be validated
-		if !isRequired {
+		// Check if the field has to be validated, the optional fields are only validated by their rules and their
+		// nested struct mapper when initialized. Their nested struct mapper is only used if it has rules, so the
+		// required fields of the optional nested structs are only enforced when opted in
+		fieldRules := mapper.GetFieldRules(fieldName)
+		fieldNestedMapper := mapper.GetFieldNestedMapper(fieldName)
+		if !isRequired && !fieldNestedMapper.HasRules() {
+			fieldNestedMapper = nil
+		}
+		if !isRequired && (!isInitialized || (len(fieldRules) == 0 && fieldNestedMapper == nil)) {
 			continue
 		}
 
@@ -195,6 +232,13 @@ func (d DefaultValidator) ValidateRequiredFields(
 			continue
 		}
 
+		// Validate the field rules
+		for _, fieldRule := range fieldRules {
+			for _, err := range fieldRule(fieldValue.Interface()) {
+				rootStructValidations.AddFieldValidationError(fieldTagName, err)
+			}
+		}
+
 		// Check if the field is a pointer
 		if fieldValue.Kind() != reflect.Ptr {
 			if fieldType.Kind() != reflect.Struct {
@@ -205,8 +249,7 @@ func (d DefaultValidator) ValidateRequiredFields(
 			continue
 		}
 
-		// Get the nested struct mapper
-		fieldNestedMapper := mapper.GetFieldNestedMapper(fieldName)
+		// Check if the field has a nested struct mapper
 		if fieldNestedMapper == nil {
 			continue
 		}
@@ -221,7 +264,7 @@ func (d DefaultValidator) ValidateRequiredFields(
 		}
 
 		// Validate the nested struct
-		err = d.ValidateRequiredFields(
+		err = d.validateRequiredFields(
 			nestedStructValidations,
 			fieldNestedMapper,
 		)
@@ -236,5 +279,91 @@ func (d DefaultValidator) ValidateRequiredFields(
 		)
 	}
 
+	// Validate the struct-level rules
+	d.validateStructRules(rootStructValidations, mapper)
+
 	return nil
 }
+
+// validateStructRules runs the struct-level rules of the mapper, adding their validation errors to the fields
+//
+// Parameters:
+//
+//   - structValidations: the struct validations
+//   - mapper: the struct mapper to use
+func (d DefaultValidator) validateStructRules(
+	structValidations *govalidatormappervalidation.StructValidations,
+	mapper *govalidatormapper.Mapper,
+) {
+	structRules := mapper.GetStructRules()
+	if len(structRules) == 0 {
+		return
+	}
+
+	// Get the struct instance
+	structInstance := structValidations.GetReflection().GetReflectedValue().Interface()
+	for _, structRule := range structRules {
+		for fieldName, errs := range structRule(structInstance) {
+			// Get the field tag name, the key is used as is if it is not a mapped field
+			fieldTagName, ok := mapper.GetFieldTagName(fieldName)
+			if !ok {
+				fieldTagName = fieldName
+			}
+
+			for _, err := range errs {
+				structValidations.AddFieldValidationError(fieldTagName, err)
+			}
+		}
+	}
+}
+
+// hasIntegerSequence checks if a value is or contains a non-empty slice or array of integers, such as []byte or
+// []rune, whose formatted values cannot be searched for card numbers. The value is walked as it is formatted, so the
+// pointers are only followed at the top level and the interfaces by their dynamic values
+//
+// Parameters:
+//
+//   - value: the value to check
+//   - depth: the nesting depth of the value
+//
+// Returns:
+//
+//   - bool: true if the value contains a sequence of integers, false otherwise
+func hasIntegerSequence(value reflect.Value, depth int) bool {
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		switch value.Type().Elem().Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return value.Len() > 0
+		}
+		for i := 0; i < value.Len(); i++ {
+			if hasIntegerSequence(value.Index(i), depth+1) {
+				return true
+			}
+		}
+	case reflect.Pointer:
+		// The nested pointers are formatted as addresses
+		if depth == 0 && !value.IsNil() {
+			return hasIntegerSequence(value.Elem(), depth+1)
+		}
+	case reflect.Interface:
+		if !value.IsNil() {
+			return hasIntegerSequence(value.Elem(), depth+1)
+		}
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			if hasIntegerSequence(iter.Key(), depth+1) || hasIntegerSequence(iter.Value(), depth+1) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if hasIntegerSequence(value.Field(i), depth+1) {
+				return true
+			}
+		}
+	}
+	return false
+}
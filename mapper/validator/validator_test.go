@@ -0,0 +1,197 @@
+package validator
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	govalidatormapper "github.com/ralvarezdev/go-validator/mapper"
+	govalidatormappervalidation "github.com/ralvarezdev/go-validator/mapper/validation"
+)
+
+func TestHasIntegerSequence(t *testing.T) {
+	var nilBytes []byte
+	for _, tt := range []struct {
+		name  string
+		value any
+		want  bool
+	}{
+		{name: "string", value: "4111111111111111"},
+		{name: "bytes", value: []byte("4111111111111111"), want: true},
+		{name: "runes", value: []rune("4111111111111111"), want: true},
+		{name: "integer array", value: [2]int{4111, 1111}, want: true},
+		{name: "empty bytes", value: nilBytes},
+		{name: "bytes in a map", value: map[string][]byte{"card": []byte("4111")}, want: true},
+		{name: "bytes in an interface", value: []any{[]byte("4111")}, want: true},
+		{name: "bytes behind a pointer", value: &struct{ Card []byte }{Card: []byte("4111")}, want: true},
+		{name: "nested pointer", value: struct{ Card *[]byte }{Card: &[]byte{4}}},
+		{name: "nil interface", value: struct{ Err error }{}},
+		{name: "protobuf message", value: wrapperspb.String("4111111111111111")},
+	} {
+		if got := hasIntegerSequence(reflect.ValueOf(tt.value), 0); got != tt.want {
+			t.Errorf("hasIntegerSequence(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidateRequiredFieldsRedactsLoggedValues(t *testing.T) {
+	type request struct {
+		Notes   string                  `json:"notes"`
+		Data    []byte                  `json:"data"`
+		Message *wrapperspb.StringValue `json:"message"`
+	}
+	instance := &request{
+		Notes:   "my card 4111 1111 1111 1111 12/25",
+		Data:    []byte("4111111111111111"),
+		Message: wrapperspb.String("card 4111111111111111 2025"),
+	}
+
+	mapper, err := govalidatormapper.NewJSONGenerator(nil).NewMapper(instance)
+	if err != nil {
+		t.Fatalf("NewMapper returned error: %v", err)
+	}
+	validations, err := govalidatormappervalidation.NewStructValidations(instance)
+	if err != nil {
+		t.Fatalf("NewStructValidations returned error: %v", err)
+	}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	if err = NewDefaultValidator(logger).ValidateRequiredFields(validations, mapper); err != nil {
+		t.Fatalf("ValidateRequiredFields returned error: %v", err)
+	}
+
+	output := logs.String()
+	for _, unwanted := range []string{"4111 1111 1111 1111", "4111111111111111", "0x34, 0x31"} {
+		if strings.Contains(output, unwanted) {
+			t.Errorf("logs contain %q:\n%s", unwanted, output)
+		}
+	}
+	for _, wanted := range []string{"**** **** **** 1111 12/25", "************1111 2025", RedactedFieldValue} {
+		if !strings.Contains(output, wanted) {
+			t.Errorf("logs do not contain %q:\n%s", wanted, output)
+		}
+	}
+}
+
+func TestValidateRequiredFieldsNestedStructs(t *testing.T) {
+	type address struct {
+		Street string `json:"street"`
+		City   string `json:"city"`
+	}
+	type request struct {
+		Name    string   `json:"name"`
+		Home    address  `json:"home"`
+		Work    *address `json:"work,omitempty"`
+		Billing *address `json:"billing,omitempty"`
+	}
+	streetRule := func(structInstance any) map[string][]error {
+		if structInstance.(address).Street == "unknown" {
+			return map[string][]error{"Street": {errors.New("street is unknown")}}
+		}
+		return nil
+	}
+
+	for _, tt := range []struct {
+		name     string
+		instance *request
+		addRule  bool
+		want     map[string]bool
+	}{
+		{
+			name:     "required nested struct",
+			instance: &request{Name: "john", Home: address{Street: "main"}},
+			want:     map[string]bool{"home": true},
+		},
+		{
+			name:     "optional nested struct without rules",
+			instance: &request{Name: "john", Home: address{Street: "main", City: "x"}, Work: &address{}},
+			want:     map[string]bool{},
+		},
+		{
+			name: "optional nested struct with rules",
+			instance: &request{
+				Name: "john",
+				Home: address{Street: "main", City: "x"},
+				Work: &address{Street: "unknown"},
+			},
+			addRule: true,
+			want:    map[string]bool{"work": true},
+		},
+		{
+			name:     "uninitialized optional nested struct with rules",
+			instance: &request{Name: "john", Home: address{Street: "main", City: "x"}},
+			addRule:  true,
+			want:     map[string]bool{},
+		},
+	} {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				mapper, err := govalidatormapper.NewJSONGenerator(nil).NewMapper(tt.instance)
+				if err != nil {
+					t.Fatalf("NewMapper returned error: %v", err)
+				}
+				if tt.addRule {
+					mapper.GetFieldNestedMapper("Work").AddStructRule(streetRule)
+				}
+				validations, err := govalidatormappervalidation.NewStructValidations(tt.instance)
+				if err != nil {
+					t.Fatalf("NewStructValidations returned error: %v", err)
+				}
+				if err = NewDefaultValidator(nil).ValidateRequiredFields(validations, mapper); err != nil {
+					t.Fatalf("ValidateRequiredFields returned error: %v", err)
+				}
+
+				failed := map[string]bool{}
+				for fieldName, nestedValidations := range validations.GetNestedStructsValidations() {
+					if nestedValidations.HasFailed() {
+						failed[fieldName] = true
+					}
+				}
+				if len(validations.GetFieldsValidations()) != 0 || !reflect.DeepEqual(failed, tt.want) {
+					t.Errorf(
+						"failed fields = %v, nested structs = %v, want nested structs %v",
+						validations.GetFieldsValidations(),
+						failed,
+						tt.want,
+					)
+				}
+			},
+		)
+	}
+}
+
+func TestValidateRequiredFieldsOptionalNestedStructRequiredFields(t *testing.T) {
+	type address struct {
+		Street string `json:"street"`
+		City   string `json:"city"`
+	}
+	type request struct {
+		Work *address `json:"work,omitempty"`
+	}
+	instance := &request{Work: &address{Street: "main"}}
+
+	mapper, err := govalidatormapper.NewJSONGenerator(nil).NewMapper(instance)
+	if err != nil {
+		t.Fatalf("NewMapper returned error: %v", err)
+	}
+	mapper.GetFieldNestedMapper("Work").AddStructRule(func(any) map[string][]error { return nil })
+	validations, err := govalidatormappervalidation.NewStructValidations(instance)
+	if err != nil {
+		t.Fatalf("NewStructValidations returned error: %v", err)
+	}
+	if err = NewDefaultValidator(nil).ValidateRequiredFields(validations, mapper); err != nil {
+		t.Fatalf("ValidateRequiredFields returned error: %v", err)
+	}
+
+	// The opted in optional nested struct has its required fields enforced
+	work := validations.GetNestedStructsValidations()["work"]
+	if work == nil || work.GetFieldsValidations()["city"] == nil {
+		t.Errorf("work validations = %v, want the city required error", work)
+	}
+}